@@ -0,0 +1,393 @@
+package analyzer
+
+import (
+	"go/ast"
+	"go/types"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/krishpranav/gomodular/internal/structtag"
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/analysis/passes/inspect"
+	"golang.org/x/tools/go/ast/inspector"
+	"golang.org/x/tools/go/types/typeutil"
+)
+
+const gomodularPath = "github.com/krishpranav/gomodular"
+
+const doc = `check for misuse of the gomodular container
+
+The gomodular analyzer reports calls that are known to fail at runtime:
+non-pointer Resolve targets, Fill targets that are not struct pointers,
+resolvers and Call receivers with invalid signatures, and invalid
+gomodular struct tags. In main packages, or in every package with -allpackages,
+it also reports types that are resolved but never bound anywhere in the
+program.`
+
+var Analyzer = &analysis.Analyzer{
+	Name:      "gomodular",
+	Doc:       doc,
+	Requires:  []*analysis.Analyzer{inspect.Analyzer},
+	Run:       run,
+	FactTypes: []analysis.Fact{new(bindings)},
+}
+
+var allPackages bool
+
+func init() {
+	Analyzer.Flags.BoolVar(&allPackages, "allpackages", false, "report unbound types in every package, not only in main packages")
+}
+
+type checker struct {
+	pass     *analysis.Pass
+	bound    *bindings
+	resolved []resolved
+}
+
+func run(pass *analysis.Pass) (interface{}, error) {
+	if pass.Pkg.Path() == gomodularPath {
+		return nil, nil
+	}
+
+	c := &checker{pass: pass, bound: &bindings{}}
+	ins := pass.ResultOf[inspect.Analyzer].(*inspector.Inspector)
+
+	nodes := []ast.Node{(*ast.CallExpr)(nil), (*ast.CompositeLit)(nil), (*ast.StructType)(nil)}
+	ins.Preorder(nodes, func(n ast.Node) {
+		switch n := n.(type) {
+		case *ast.CallExpr:
+			c.call(n)
+		case *ast.CompositeLit:
+			c.literal(n)
+		case *ast.StructType:
+			c.tags(n)
+		}
+	})
+
+	c.bound.sort()
+	if len(c.bound.Types) > 0 || c.bound.Unknown {
+		pass.ExportPackageFact(c.bound)
+	}
+
+	if allPackages || pass.Pkg.Name() == "main" {
+		c.unbound()
+	}
+
+	return nil, nil
+}
+
+func (c *checker) call(call *ast.CallExpr) {
+	id := funcIdent(call.Fun)
+	if id == nil {
+		return
+	}
+	fn, ok := typeutil.Callee(c.pass.TypesInfo, call).(*types.Func)
+	if !ok || fn.Pkg() == nil || fn.Pkg().Path() != gomodularPath || len(call.Args) == 0 {
+		return
+	}
+
+	var typeArgs *types.TypeList
+	if inst, ok := c.pass.TypesInfo.Instances[id]; ok {
+		typeArgs = inst.TypeArgs
+	}
+
+	name := strings.TrimPrefix(strings.TrimPrefix(fn.Name(), "Must"), "Named")
+	name = strings.TrimSuffix(name, "Context")
+	last := call.Args[len(call.Args)-1]
+
+	switch name {
+	case "Singleton", "SingletonLazy", "Transient", "TransientLazy", "Scoped", "Register", "Replace", "Contribute":
+		c.resolver(last, fn.Name())
+	case "Bind", "BindLazy", "BindTransient", "BindTransientLazy", "BindFunc", "BindLazyFunc", "BindTransientFunc", "BindTransientLazyFunc":
+		c.resolver(last, fn.Name())
+		if typeArgs != nil {
+			c.bound.add(typeArgs.At(0))
+		}
+	case "Resolve":
+		if arg := argument(call, fn, "abstraction"); arg != nil {
+			c.abstraction(arg, fn.Name())
+		}
+	case "ResolveAs":
+		if typeArgs != nil {
+			c.resolve(call, typeArgs.At(0))
+		}
+	case "Fill", "FillRecursive":
+		c.structure(last, fn.Name())
+	case "Call":
+		c.receiver(last, fn.Name())
+	}
+}
+
+func (c *checker) literal(lit *ast.CompositeLit) {
+	t := c.pass.TypesInfo.TypeOf(lit)
+	if t == nil {
+		return
+	}
+
+	for _, elt := range lit.Elts {
+		kv, ok := elt.(*ast.KeyValueExpr)
+		if !ok {
+			continue
+		}
+		key, ok := kv.Key.(*ast.Ident)
+		if !ok {
+			continue
+		}
+
+		switch {
+		case isNamed(t, gomodularPath, "Provider") && key.Name == "Resolver":
+			c.resolver(kv.Value, "Provider")
+		case isNamed(t, gomodularPath, "Module") && key.Name == "Invokes":
+			if invokes, ok := kv.Value.(*ast.CompositeLit); ok {
+				for _, invoke := range invokes.Elts {
+					c.receiver(invoke, "Module")
+				}
+			}
+		}
+	}
+}
+
+func (c *checker) resolver(expr ast.Expr, fn string) {
+	t := c.pass.TypesInfo.TypeOf(expr)
+	if t == nil {
+		return
+	}
+	if types.IsInterface(t) {
+		c.bound.Unknown = true
+		return
+	}
+
+	sig, ok := t.Underlying().(*types.Signature)
+	if !ok {
+		c.pass.Reportf(expr.Pos(), "invalid resolver passed to %s: the resolver must be a function, got %s", fn, c.typeString(t))
+		return
+	}
+
+	results := sig.Results()
+	if results.Len() == 0 || results.Len() > 2 || (results.Len() == 2 && !isError(results.At(1).Type())) {
+		c.pass.Reportf(expr.Pos(), "invalid resolver passed to %s: it must return abstract, or abstract and error", fn)
+		return
+	}
+
+	abstraction := results.At(0).Type()
+	for i := 0; i < sig.Params().Len(); i++ {
+		if types.Identical(sig.Params().At(i).Type(), abstraction) {
+			c.pass.Reportf(expr.Pos(), "invalid resolver passed to %s: it depends on the %s it returns", fn, c.typeString(abstraction))
+			return
+		}
+	}
+
+	c.bound.add(abstraction)
+	c.dependencies(expr, sig)
+}
+
+func (c *checker) receiver(expr ast.Expr, fn string) {
+	t := c.pass.TypesInfo.TypeOf(expr)
+	if t == nil || types.IsInterface(t) {
+		return
+	}
+
+	sig, ok := t.Underlying().(*types.Signature)
+	if !ok {
+		c.pass.Reportf(expr.Pos(), "invalid function passed to %s: got %s", fn, c.typeString(t))
+		return
+	}
+
+	results := sig.Results()
+	if results.Len() > 1 || (results.Len() == 1 && !isError(results.At(0).Type())) {
+		c.pass.Reportf(expr.Pos(), "receiver function signature is invalid: %s receivers must return nothing or an error", fn)
+		return
+	}
+
+	c.dependencies(expr, sig)
+}
+
+func (c *checker) abstraction(expr ast.Expr, fn string) {
+	t := c.pass.TypesInfo.TypeOf(expr)
+	if t == nil || isEmptyInterface(t) {
+		return
+	}
+
+	ptr, ok := t.Underlying().(*types.Pointer)
+	if !ok {
+		c.pass.Reportf(expr.Pos(), "invalid abstraction passed to %s: it must be a pointer, got %s", fn, c.typeString(t))
+		return
+	}
+
+	c.resolve(expr, ptr.Elem())
+}
+
+func (c *checker) structure(expr ast.Expr, fn string) {
+	t := c.pass.TypesInfo.TypeOf(expr)
+	if t == nil || isEmptyInterface(t) {
+		return
+	}
+
+	ptr, ok := t.Underlying().(*types.Pointer)
+	if !ok {
+		c.pass.Reportf(expr.Pos(), "invalid structure passed to %s: it must be a pointer to a struct, got %s", fn, c.typeString(t))
+		return
+	}
+	st, ok := ptr.Elem().Underlying().(*types.Struct)
+	if !ok {
+		c.pass.Reportf(expr.Pos(), "invalid structure passed to %s: it must be a pointer to a struct, got %s", fn, c.typeString(t))
+		return
+	}
+
+	c.fields(expr, st)
+}
+
+func (c *checker) dependencies(expr ast.Expr, sig *types.Signature) {
+	for i := 0; i < sig.Params().Len(); i++ {
+		t := sig.Params().At(i).Type()
+		switch {
+		case isNamed(t, "context", "Context"), isNamed(t, gomodularPath, "Lifecycle"), isNamed(t, gomodularPath, "Optional"):
+		case isIn(t):
+			c.fields(expr, t.Underlying().(*types.Struct))
+		default:
+			c.resolve(expr, t)
+		}
+	}
+}
+
+func (c *checker) fields(expr ast.Expr, st *types.Struct) {
+	for i := 0; i < st.NumFields(); i++ {
+		f := st.Field(i)
+		value, exist := reflect.StructTag(st.Tag(i)).Lookup("gomodular")
+		if !exist {
+			continue
+		}
+
+		tag, err := structtag.Parse(f.Name(), value)
+		if err != nil || tag.Optional || isNamed(f.Type(), gomodularPath, "Optional") {
+			continue
+		}
+
+		t := f.Type()
+		if tag.Lazy {
+			sig, ok := t.Underlying().(*types.Signature)
+			if !ok || !isLazy(sig) {
+				continue
+			}
+			t = sig.Results().At(0).Type()
+		}
+		c.resolve(expr, t)
+	}
+}
+
+func (c *checker) tags(st *ast.StructType) {
+	for _, field := range st.Fields.List {
+		if field.Tag == nil {
+			continue
+		}
+		raw, err := strconv.Unquote(field.Tag.Value)
+		if err != nil {
+			continue
+		}
+		value, exist := reflect.StructTag(raw).Lookup("gomodular")
+		if !exist {
+			continue
+		}
+
+		names := field.Names
+		if len(names) == 0 {
+			if id := typeIdent(field.Type); id != nil {
+				names = []*ast.Ident{id}
+			}
+		}
+
+		for _, name := range names {
+			tag, err := structtag.Parse(name.Name, value)
+			if err != nil {
+				c.pass.Reportf(field.Tag.Pos(), "%s has an invalid struct tag: %s", name.Name, err)
+				continue
+			}
+
+			if tag.Lazy {
+				sig, ok := c.pass.TypesInfo.TypeOf(field.Type).Underlying().(*types.Signature)
+				if !ok || !isLazy(sig) {
+					c.pass.Reportf(field.Tag.Pos(), "%s has an invalid struct tag: lazy field must be a func() (T, error)", name.Name)
+				}
+			}
+		}
+	}
+}
+
+func (c *checker) typeString(t types.Type) string {
+	return types.TypeString(t, types.RelativeTo(c.pass.Pkg))
+}
+
+func argument(call *ast.CallExpr, fn *types.Func, name string) ast.Expr {
+	params := fn.Type().(*types.Signature).Params()
+	for i := 0; i < params.Len() && i < len(call.Args); i++ {
+		if params.At(i).Name() == name {
+			return call.Args[i]
+		}
+	}
+	return nil
+}
+
+func funcIdent(expr ast.Expr) *ast.Ident {
+	switch e := ast.Unparen(expr).(type) {
+	case *ast.Ident:
+		return e
+	case *ast.SelectorExpr:
+		return e.Sel
+	case *ast.IndexExpr:
+		return funcIdent(e.X)
+	case *ast.IndexListExpr:
+		return funcIdent(e.X)
+	}
+	return nil
+}
+
+func typeIdent(expr ast.Expr) *ast.Ident {
+	switch e := expr.(type) {
+	case *ast.Ident:
+		return e
+	case *ast.SelectorExpr:
+		return e.Sel
+	case *ast.StarExpr:
+		return typeIdent(e.X)
+	case *ast.IndexExpr:
+		return typeIdent(e.X)
+	case *ast.IndexListExpr:
+		return typeIdent(e.X)
+	}
+	return nil
+}
+
+func isNamed(t types.Type, path, name string) bool {
+	named, ok := types.Unalias(t).(*types.Named)
+	if !ok || named.Obj().Pkg() == nil {
+		return false
+	}
+	return named.Obj().Pkg().Path() == path && named.Obj().Name() == name
+}
+
+func isError(t types.Type) bool {
+	return types.Identical(t, types.Universe.Lookup("error").Type())
+}
+
+func isIn(t types.Type) bool {
+	st, ok := t.Underlying().(*types.Struct)
+	if !ok {
+		return false
+	}
+	for i := 0; i < st.NumFields(); i++ {
+		if st.Field(i).Embedded() && isNamed(st.Field(i).Type(), gomodularPath, "In") {
+			return true
+		}
+	}
+	return false
+}
+
+func isLazy(sig *types.Signature) bool {
+	return sig.Params().Len() == 0 && sig.Results().Len() == 2 && isError(sig.Results().At(1).Type())
+}
+
+func isEmptyInterface(t types.Type) bool {
+	iface, ok := t.Underlying().(*types.Interface)
+	return ok && iface.NumMethods() == 0
+}
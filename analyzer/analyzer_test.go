@@ -0,0 +1,30 @@
+package analyzer_test
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/krishpranav/gomodular/analyzer"
+	"golang.org/x/tools/go/analysis/analysistest"
+)
+
+func TestAnalyzer(t *testing.T) {
+	analysistest.Run(t, testdata(t), analyzer.Analyzer, "example.com/testdata/app", "example.com/testdata/lib", "example.com/testdata/dynamic")
+}
+
+func TestAnalyzer_AllPackages(t *testing.T) {
+	if err := analyzer.Analyzer.Flags.Set("allpackages", "true"); err != nil {
+		t.Fatal(err)
+	}
+	defer analyzer.Analyzer.Flags.Set("allpackages", "false")
+
+	analysistest.Run(t, testdata(t), analyzer.Analyzer, "example.com/testdata/worker")
+}
+
+func testdata(t *testing.T) string {
+	dir, err := filepath.Abs("testdata")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return dir
+}
@@ -0,0 +1,126 @@
+package analyzer
+
+import (
+	"go/ast"
+	"go/types"
+	"sort"
+	"strings"
+)
+
+type bindings struct {
+	Types   []string
+	Unknown bool
+}
+
+func (*bindings) AFact() {}
+
+func (b *bindings) String() string {
+	list := b.Types
+	if b.Unknown {
+		list = append(list[:len(list):len(list)], "unknown")
+	}
+	return "bindings(" + strings.Join(list, ", ") + ")"
+}
+
+func (b *bindings) add(t types.Type) {
+	b.Types = append(b.Types, types.TypeString(t, nil))
+}
+
+func (b *bindings) sort() {
+	sort.Strings(b.Types)
+	unique := b.Types[:0]
+	for i, t := range b.Types {
+		if i == 0 || t != b.Types[i-1] {
+			unique = append(unique, t)
+		}
+	}
+	b.Types = unique
+}
+
+type resolved struct {
+	node ast.Node
+	typ  types.Type
+}
+
+func (c *checker) resolve(node ast.Node, t types.Type) {
+	c.resolved = append(c.resolved, resolved{node: node, typ: t})
+}
+
+func (c *checker) unbound() {
+	bound := make(map[string]bool)
+	unknown := c.bound.Unknown
+	for _, t := range c.bound.Types {
+		bound[t] = true
+	}
+	for _, f := range c.pass.AllPackageFacts() {
+		b := f.Fact.(*bindings)
+		unknown = unknown || b.Unknown
+		for _, t := range b.Types {
+			bound[t] = true
+		}
+	}
+	if unknown {
+		return
+	}
+
+	reported := make(map[ast.Node]map[string]bool)
+	for _, r := range c.resolved {
+		if containsTypeParam(r.typ) || isBound(bound, r.typ) {
+			continue
+		}
+
+		key := types.TypeString(r.typ, nil)
+		if reported[r.node] == nil {
+			reported[r.node] = make(map[string]bool)
+		}
+		if reported[r.node][key] {
+			continue
+		}
+		reported[r.node][key] = true
+
+		c.pass.Reportf(r.node.Pos(), "%s is resolved but never bound", c.typeString(r.typ))
+	}
+}
+
+func isBound(bound map[string]bool, t types.Type) bool {
+	if bound[types.TypeString(t, nil)] {
+		return true
+	}
+
+	switch u := t.Underlying().(type) {
+	case *types.Slice:
+		return bound[types.TypeString(u.Elem(), nil)]
+	case *types.Map:
+		return isString(u.Key()) && bound[types.TypeString(u.Elem(), nil)]
+	}
+	return false
+}
+
+func isString(t types.Type) bool {
+	basic, ok := t.Underlying().(*types.Basic)
+	return ok && basic.Kind() == types.String
+}
+
+func containsTypeParam(t types.Type) bool {
+	switch u := types.Unalias(t).(type) {
+	case *types.TypeParam:
+		return true
+	case *types.Pointer:
+		return containsTypeParam(u.Elem())
+	case *types.Slice:
+		return containsTypeParam(u.Elem())
+	case *types.Array:
+		return containsTypeParam(u.Elem())
+	case *types.Map:
+		return containsTypeParam(u.Key()) || containsTypeParam(u.Elem())
+	case *types.Chan:
+		return containsTypeParam(u.Elem())
+	case *types.Named:
+		for i := 0; i < u.TypeArgs().Len(); i++ {
+			if containsTypeParam(u.TypeArgs().At(i)) {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,10 @@
+package main
+
+import (
+	"github.com/krishpranav/gomodular/analyzer"
+	"golang.org/x/tools/go/analysis/singlechecker"
+)
+
+func main() {
+	singlechecker.Main(analyzer.Analyzer)
+}
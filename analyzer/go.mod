@@ -0,0 +1,13 @@
+module github.com/krishpranav/gomodular/analyzer
+
+go 1.23.0
+
+require (
+	github.com/krishpranav/gomodular v0.0.0-20261018042737-3c0d2023a9a9
+	golang.org/x/tools v0.35.0
+)
+
+require (
+	golang.org/x/mod v0.26.0 // indirect
+	golang.org/x/sync v0.16.0 // indirect
+)
@@ -0,0 +1,60 @@
+package main // want package:"bindings\\(example.com/testdata/lib.Shape\\)"
+
+import (
+	"github.com/krishpranav/gomodular"
+
+	"example.com/testdata/lib"
+)
+
+type Config struct {
+	gomodular.In
+
+	DB     lib.Database                   `gomodular:"type"`
+	Cache  lib.Cache                      `gomodular:"optional"`
+	Mail   gomodular.Optional[lib.Mailer] `gomodular:"type"`
+	Shapes []lib.Shape                    `gomodular:"type"`
+	Lazy   func() (lib.Database, error)   `gomodular:"lazy"`
+	Broken lib.Database                   `gomodular:"name=a,name=b"` // want `Broken has an invalid struct tag: duplicate key "name"`
+	Bad    func() lib.Database            `gomodular:"lazy"`          // want `Bad has an invalid struct tag: lazy field must be a func\(\) \(T, error\)`
+	Other  map[string]lib.Shape           `gomodular:"type"`
+	Weird  lib.Shape                      `json:"weird"`
+}
+
+type Logger struct{}
+
+func main() {
+	c := gomodular.New()
+
+	_ = c.Install(lib.Module)
+	_ = lib.Register(c)
+	_ = c.Singleton(func() lib.Shape { return nil })
+	_ = c.Transient(func() {})                                                   // want `invalid resolver passed to Transient: it must return abstract, or abstract and error`
+	_ = gomodular.NamedSingleton("x", func() (lib.Shape, int) { return nil, 0 }) // want `invalid resolver passed to NamedSingleton: it must return abstract, or abstract and error`
+	_ = c.Scoped(func(s lib.Shape) lib.Shape { return s })                       // want `invalid resolver passed to Scoped: it depends on the example.com/testdata/lib.Shape it returns`
+	gomodular.MustSingleton(c, "resolver")                                       // want `invalid resolver passed to MustSingleton: the resolver must be a function, got string`
+
+	var db lib.Database
+	_ = c.Resolve(db) // want `invalid abstraction passed to Resolve: it must be a pointer, got example.com/testdata/lib.Database`
+	_ = c.NamedResolve(&db, "primary")
+	gomodular.MustNamedResolve(c, db, "primary") // want `invalid abstraction passed to MustNamedResolve: it must be a pointer, got example.com/testdata/lib.Database`
+	_ = gomodular.ResolveContext(nil, &db)
+
+	var logger Logger
+	_ = c.Resolve(&logger)                 // want `Logger is resolved but never bound`
+	_, _ = gomodular.ResolveAs[*Logger](c) // want `\*Logger is resolved but never bound`
+
+	var cfg Config
+	_ = c.Fill(&cfg)
+	_ = c.Fill(cfg)         // want `invalid structure passed to Fill: it must be a pointer to a struct, got Config`
+	_ = gomodular.Fill(&db) // want `invalid structure passed to Fill: it must be a pointer to a struct, got \*example.com/testdata/lib.Database`
+
+	_ = c.Call(func(db lib.Database, cfg Config) error { return nil })
+	_ = c.Call(func(db lib.Database) (int, error) { return 0, nil }) // want `receiver function signature is invalid: Call receivers must return nothing or an error`
+	_ = c.Call(func(l Logger) {})                                    // want `Logger is resolved but never bound`
+	_ = c.Call(func(names []string) {})                              // want `\[\]string is resolved but never bound`
+	_ = c.Call(42)                                                   // want `invalid function passed to Call: got int`
+
+	var any interface{} = func() {}
+	_ = c.Call(any)
+	_ = c.Resolve(any)
+}
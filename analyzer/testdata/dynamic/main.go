@@ -0,0 +1,13 @@
+package main // want package:"bindings\\(unknown\\)"
+
+import "github.com/krishpranav/gomodular"
+
+type Queue struct{}
+
+func main() {
+	var resolver interface{} = func() *Queue { return &Queue{} }
+	_ = gomodular.Singleton(resolver)
+
+	var q *Queue
+	_ = gomodular.Resolve(&q)
+}
@@ -0,0 +1,7 @@
+module example.com/testdata
+
+go 1.23.0
+
+require github.com/krishpranav/gomodular v0.0.0-00010101000000-000000000000
+
+replace github.com/krishpranav/gomodular => ../../
@@ -0,0 +1,64 @@
+package lib // want package:"bindings\\(example.com/testdata/lib.Cache, example.com/testdata/lib.Database, example.com/testdata/lib.Mailer, example.com/testdata/lib.Queue\\)"
+
+import (
+	"context"
+
+	"github.com/krishpranav/gomodular"
+)
+
+type Database interface {
+	Query(string) error
+}
+
+type Mailer interface {
+	Send(string) error
+}
+
+type Cache interface {
+	Get(string) string
+}
+
+type Queue interface {
+	Push(string) error
+}
+
+type Shape interface {
+	Area() int
+}
+
+type mysql struct{}
+
+func (mysql) Query(string) error { return nil }
+
+func NewDatabase(ctx context.Context, lc gomodular.Lifecycle) (Database, error) {
+	return mysql{}, nil
+}
+
+var Module = &gomodular.Module{
+	Name: "storage",
+	Providers: []gomodular.Provider{
+		{Resolver: NewDatabase},
+		{Resolver: func() (Database, Mailer, error) { return nil, nil, nil }}, // want `invalid resolver passed to Provider: it must return abstract, or abstract and error`
+	},
+	Invokes: []interface{}{
+		func(db Database) error { return nil },
+		func(db Database) int { return 0 }, // want `receiver function signature is invalid: Module receivers must return nothing or an error`
+	},
+}
+
+func Register(c *gomodular.Gomodular) error {
+	if err := gomodular.Bind[Mailer](c, func() Mailer { return nil }); err != nil {
+		return err
+	}
+
+	if err := gomodular.BindFunc[Cache](c, func() Cache { return nil }); err != nil {
+		return err
+	}
+
+	if err := gomodular.NamedBindTransientLazyFunc[Queue](c, "jobs", func() (Queue, error) { return nil, nil }); err != nil {
+		return err
+	}
+
+	var shape Shape
+	return c.Resolve(&shape)
+}
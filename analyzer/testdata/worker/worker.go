@@ -0,0 +1,13 @@
+package worker
+
+import (
+	"github.com/krishpranav/gomodular"
+
+	"example.com/testdata/lib"
+)
+
+type Queue struct{}
+
+func Run(c *gomodular.Gomodular) error {
+	return c.Call(func(db lib.Database, q *Queue) error { return nil }) // want `\*Queue is resolved but never bound`
+}
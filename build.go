@@ -0,0 +1,172 @@
+package gomodular
+
+import (
+	"context"
+	"errors"
+	"runtime"
+)
+
+func (c *Gomodular) Build() error {
+	if err := c.Validate(); err != nil {
+		return err
+	}
+
+	for _, e := range c.plan() {
+		if err := c.build(nil, e); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (c *Gomodular) plan() []entry {
+	var pending []entry
+	for _, e := range c.allEntries() {
+		if e.binding.isDeferred() {
+			pending = append(pending, e)
+		}
+	}
+
+	visited := make(map[*binding]bool, len(pending))
+	var order []entry
+
+	var visit func(e entry)
+	visit = func(e entry) {
+		if visited[e.binding] {
+			return
+		}
+		visited[e.binding] = true
+
+		for _, target := range c.deferredDependencies(e) {
+			visit(target)
+		}
+
+		order = append(order, e)
+	}
+
+	for _, e := range pending {
+		visit(e)
+	}
+
+	return order
+}
+
+func (c *Gomodular) deferredDependencies(e entry) []entry {
+	var deps []entry
+	for _, d := range e.binding.dependencies() {
+		if d.Lazy {
+			continue
+		}
+		for _, target := range e.binding.home(c).targets(d) {
+			if target.binding.isDeferred() {
+				deps = append(deps, target)
+			}
+		}
+	}
+
+	return deps
+}
+
+func (c *Gomodular) BuildParallel(ctx context.Context, maxWorkers int) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	if err := c.Validate(); err != nil {
+		return err
+	}
+	if maxWorkers <= 0 {
+		maxWorkers = runtime.GOMAXPROCS(0)
+	}
+
+	order := c.plan()
+	index := make(map[*binding]int, len(order))
+	for i, e := range order {
+		index[e.binding] = i
+	}
+
+	dependents := make([][]int, len(order))
+	waiting := make([]int, len(order))
+	var ready []int
+	for i, e := range order {
+		for _, dep := range c.deferredDependencies(e) {
+			j := index[dep.binding]
+			dependents[j] = append(dependents[j], i)
+			waiting[i]++
+		}
+		if waiting[i] == 0 {
+			ready = append(ready, i)
+		}
+	}
+
+	buildCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	type result struct {
+		i   int
+		err error
+	}
+	results := make(chan result)
+
+	var errs []error
+	running, failed := 0, false
+	for len(ready) > 0 || running > 0 {
+		for !failed && len(ready) > 0 && running < maxWorkers {
+			i := ready[0]
+			ready = ready[1:]
+			running++
+
+			go func(i int) {
+				results <- result{i: i, err: c.build(&resolution{ctx: buildCtx}, order[i])}
+			}(i)
+		}
+		if running == 0 {
+			break
+		}
+
+		res := <-results
+		running--
+
+		if res.err != nil {
+			if !failed || ctx.Err() != nil || !errors.Is(res.err, context.Canceled) {
+				errs = append(errs, res.err)
+			}
+			failed = true
+			cancel()
+			continue
+		}
+
+		for _, j := range dependents[res.i] {
+			waiting[j]--
+			if waiting[j] == 0 {
+				ready = append(ready, j)
+			}
+		}
+	}
+
+	return newMultiError(errs)
+}
+
+func (c *Gomodular) build(r *resolution, e entry) error {
+	r, err := r.enter(e.abstraction, e.name, e.binding)
+	if err != nil {
+		return err
+	}
+
+	if _, err := e.binding.make(e.owner, r); err != nil {
+		return err
+	}
+
+	e.binding.mu.Lock()
+	e.binding.deferred = false
+	e.binding.mu.Unlock()
+
+	return nil
+}
+
+func (b *binding) isDeferred() bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	return b.deferred
+}
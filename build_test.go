@@ -0,0 +1,269 @@
+package gomodular_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/krishpranav/gomodular"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGomodular_Build(t *testing.T) {
+	c := gomodular.New(gomodular.WithDeferredBuild())
+
+	var order []string
+	err := c.Singleton(func(s Shape) Database {
+		order = append(order, "database")
+		return &MySQL{}
+	})
+	assert.NoError(t, err)
+
+	err = c.Singleton(func() Shape {
+		order = append(order, "shape")
+		return &Circle{a: 13}
+	})
+	assert.NoError(t, err)
+
+	err = c.Transient(func(db Database) int {
+		order = append(order, "int")
+		return 7
+	})
+	assert.NoError(t, err)
+	assert.Empty(t, order)
+
+	for _, b := range c.Bindings() {
+		assert.False(t, b.Instantiated)
+	}
+
+	err = c.Build()
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"shape", "database", "int"}, order)
+
+	for _, b := range c.Bindings() {
+		assert.True(t, b.Instantiated)
+	}
+
+	err = c.Build()
+	assert.NoError(t, err)
+	assert.Len(t, order, 3)
+
+	var db Database
+	assert.NoError(t, c.Resolve(&db))
+	assert.Len(t, order, 3)
+}
+
+func TestGomodular_Build_Reports_All_Errors_Before_Building(t *testing.T) {
+	c := gomodular.New(gomodular.WithDeferredBuild())
+
+	built := false
+	err := c.Singleton(func() Shape {
+		built = true
+		return &Circle{}
+	})
+	assert.NoError(t, err)
+
+	err = c.Singleton(func(s Shape, m Mailer) Database {
+		return &MySQL{}
+	})
+	assert.NoError(t, err)
+
+	err = c.NamedSingleton("a", func(db Database) int {
+		return 1
+	})
+	assert.NoError(t, err)
+
+	err = c.Singleton(func(i int) string {
+		return ""
+	})
+	assert.NoError(t, err)
+
+	err = c.Singleton(func(s string) int {
+		return 2
+	})
+	assert.ErrorIs(t, err, gomodular.ErrCircularDependency)
+
+	err = c.Build()
+	assert.Regexp(t, `^gomodular: no concrete found for: gomodular_test\.Mailer .*; `+
+		`gomodular: no concrete found for: int .*$`, err.Error())
+	assert.False(t, built)
+}
+
+func TestGomodular_Build_With_Resolver_Error(t *testing.T) {
+	c := gomodular.New(gomodular.WithDeferredBuild())
+
+	err := c.Singleton(func() (Shape, error) {
+		return nil, errors.New("app: error")
+	})
+	assert.NoError(t, err)
+
+	err = c.Build()
+	assert.ErrorIs(t, err, gomodular.ErrResolverFailed)
+	assert.False(t, c.Bindings()[0].Instantiated)
+}
+
+func TestGomodular_Build_With_Modules(t *testing.T) {
+	c := gomodular.New(gomodular.WithDeferredBuild())
+
+	err := c.Install(&gomodular.Module{
+		Name: "storage",
+		Providers: []gomodular.Provider{
+			{Resolver: func(s Shape) Database { return &MySQL{} }},
+			{Resolver: func() Shape { return &Circle{} }, Private: true},
+		},
+	})
+	assert.NoError(t, err)
+
+	assert.NoError(t, c.Build())
+	assert.True(t, c.Bindings()[0].Instantiated)
+}
+
+func TestGomodular_BuildParallel(t *testing.T) {
+	c := gomodular.New(gomodular.WithDeferredBuild())
+
+	var started sync.WaitGroup
+	started.Add(2)
+	rendezvous := func() {
+		started.Done()
+		started.Wait()
+	}
+
+	err := c.Singleton(func() Shape {
+		rendezvous()
+		return &Circle{a: 13}
+	})
+	assert.NoError(t, err)
+
+	err = c.Singleton(func() string {
+		rendezvous()
+		return "mailer"
+	})
+	assert.NoError(t, err)
+
+	err = c.Singleton(func(s Shape, m string) Database {
+		return &MySQL{}
+	})
+	assert.NoError(t, err)
+
+	done := make(chan error)
+	go func() {
+		done <- c.BuildParallel(context.Background(), 2)
+	}()
+
+	select {
+	case err := <-done:
+		assert.NoError(t, err)
+	case <-time.After(5 * time.Second):
+		t.Fatal("independent singletons were not built in parallel")
+	}
+
+	for _, b := range c.Bindings() {
+		assert.True(t, b.Instantiated)
+	}
+}
+
+func TestGomodular_BuildParallel_Limits_Workers(t *testing.T) {
+	c := gomodular.New(gomodular.WithDeferredBuild())
+
+	var mu sync.Mutex
+	running, peak := 0, 0
+	track := func() {
+		mu.Lock()
+		running++
+		if running > peak {
+			peak = running
+		}
+		mu.Unlock()
+
+		time.Sleep(10 * time.Millisecond)
+
+		mu.Lock()
+		running--
+		mu.Unlock()
+	}
+
+	for _, name := range []string{"a", "b", "c", "d"} {
+		err := c.NamedSingleton(name, func() Shape {
+			track()
+			return &Circle{}
+		})
+		assert.NoError(t, err)
+	}
+
+	err := c.BuildParallel(context.Background(), 1)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, peak)
+}
+
+func TestGomodular_BuildParallel_Cancels_Siblings_On_Failure(t *testing.T) {
+	c := gomodular.New(gomodular.WithDeferredBuild())
+
+	failing := make(chan struct{})
+	err := c.Singleton(func() (Shape, error) {
+		<-failing
+		return nil, errors.New("app: error")
+	})
+	assert.NoError(t, err)
+
+	cancelled := false
+	err = c.Singleton(func(ctx context.Context) (Mailer, error) {
+		close(failing)
+		<-ctx.Done()
+		cancelled = true
+		return nil, ctx.Err()
+	})
+	assert.NoError(t, err)
+
+	dependent := false
+	err = c.Singleton(func(s Shape) Database {
+		dependent = true
+		return &MySQL{}
+	})
+	assert.NoError(t, err)
+
+	err = c.BuildParallel(context.Background(), 2)
+	assert.ErrorIs(t, err, gomodular.ErrResolverFailed)
+	assert.EqualError(t, err, "gomodular: resolver for gomodular_test.Shape (registered at "+c.Bindings()[0].Source+") failed: app: error")
+	assert.True(t, cancelled)
+	assert.False(t, dependent)
+}
+
+func TestGomodular_BuildParallel_Aggregates_Errors(t *testing.T) {
+	c := gomodular.New(gomodular.WithDeferredBuild())
+
+	var started sync.WaitGroup
+	started.Add(2)
+
+	err := c.Singleton(func() (Shape, error) {
+		started.Done()
+		started.Wait()
+		return nil, errors.New("app: shape")
+	})
+	assert.NoError(t, err)
+
+	err = c.Singleton(func() (Mailer, error) {
+		started.Done()
+		started.Wait()
+		return nil, errors.New("app: mailer")
+	})
+	assert.NoError(t, err)
+
+	err = c.BuildParallel(context.Background(), 2)
+
+	var multiErr *gomodular.MultiError
+	if assert.ErrorAs(t, err, &multiErr) {
+		assert.Len(t, multiErr.Errors, 2)
+	}
+}
+
+func TestGomodular_BuildParallel_With_Cancelled_Context(t *testing.T) {
+	c := gomodular.New(gomodular.WithDeferredBuild())
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	err := c.BuildParallel(ctx, 1)
+	assert.ErrorIs(t, err, context.Canceled)
+}
@@ -0,0 +1,92 @@
+package gomodular
+
+import (
+	"context"
+	"io"
+	"reflect"
+)
+
+type Shutdowner interface {
+	Shutdown(ctx context.Context) error
+}
+
+func (c *Gomodular) track(instances ...interface{}) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for _, instance := range instances {
+		switch instance.(type) {
+		case Shutdowner, io.Closer:
+		default:
+			continue
+		}
+
+		if !containsInstance(c.closers, instance) {
+			c.closers = append(c.closers, instance)
+		}
+	}
+}
+
+func containsInstance(instances []interface{}, instance interface{}) bool {
+	if !reflect.TypeOf(instance).Comparable() {
+		return false
+	}
+
+	for _, i := range instances {
+		if reflect.TypeOf(i) == reflect.TypeOf(instance) && i == instance {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (c *Gomodular) Close(ctx context.Context) error {
+	return newMultiError(c.close(ctx))
+}
+
+func (c *Gomodular) close(ctx context.Context) []error {
+	c.mu.Lock()
+	closers := c.closers
+	c.closers = nil
+	c.mu.Unlock()
+
+	var errs []error
+	for i := len(closers) - 1; i >= 0; i-- {
+		if err := ctx.Err(); err != nil {
+			return append(errs, err)
+		}
+
+		if err := closeInstance(ctx, closers[i]); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	modules := c.installedModules()
+	for i := len(modules) - 1; i >= 0; i-- {
+		errs = append(errs, modules[i].close(ctx)...)
+		if ctx.Err() != nil {
+			break
+		}
+	}
+
+	return errs
+}
+
+func closeInstance(ctx context.Context, instance interface{}) error {
+	if s, ok := instance.(Shutdowner); ok {
+		return s.Shutdown(ctx)
+	}
+
+	done := make(chan error, 1)
+	go func() {
+		done <- instance.(io.Closer).Close()
+	}()
+
+	select {
+	case err := <-done:
+		return err
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
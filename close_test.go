@@ -0,0 +1,194 @@
+package gomodular_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/krishpranav/gomodular"
+	"github.com/stretchr/testify/assert"
+)
+
+type Pool struct {
+	name   string
+	closed *[]string
+	err    error
+}
+
+func (p *Pool) Connect() bool {
+	return true
+}
+
+func (p *Pool) Close() error {
+	*p.closed = append(*p.closed, p.name)
+	return p.err
+}
+
+type Server struct {
+	closed *[]string
+	wait   time.Duration
+}
+
+func (s *Server) SetArea(int) {}
+
+func (s *Server) GetArea() int {
+	return 0
+}
+
+func (s *Server) Shutdown(ctx context.Context) error {
+	select {
+	case <-time.After(s.wait):
+		*s.closed = append(*s.closed, "server")
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func TestGomodular_Close_In_Reverse_Order(t *testing.T) {
+	c := gomodular.New()
+	var closed []string
+
+	err := c.Singleton(func() Database {
+		return &Pool{name: "db", closed: &closed}
+	})
+	assert.NoError(t, err)
+
+	err = c.SingletonLazy(func(db Database) Shape {
+		return &Server{closed: &closed}
+	})
+	assert.NoError(t, err)
+
+	var s Shape
+	assert.NoError(t, c.Resolve(&s))
+
+	err = c.Close(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"server", "db"}, closed)
+
+	err = c.Close(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"server", "db"}, closed)
+}
+
+func TestGomodular_Close_Skips_Lazy_And_Transient_Instances(t *testing.T) {
+	c := gomodular.New()
+	var closed []string
+
+	err := c.SingletonLazy(func() Database {
+		return &Pool{name: "lazy", closed: &closed}
+	})
+	assert.NoError(t, err)
+
+	err = c.Transient(func() Shape {
+		return &Server{closed: &closed}
+	})
+	assert.NoError(t, err)
+
+	err = c.Close(context.Background())
+	assert.NoError(t, err)
+	assert.Empty(t, closed)
+}
+
+func TestGomodular_Close_Collects_Errors(t *testing.T) {
+	c := gomodular.New()
+	var closed []string
+
+	err1 := errors.New("app: first")
+	err2 := errors.New("app: second")
+
+	err := c.NamedSingleton("first", func() Database {
+		return &Pool{name: "first", closed: &closed, err: err1}
+	})
+	assert.NoError(t, err)
+
+	err = c.NamedSingleton("second", func() Database {
+		return &Pool{name: "second", closed: &closed, err: err2}
+	})
+	assert.NoError(t, err)
+
+	err = c.Close(context.Background())
+	assert.EqualError(t, err, "app: second; app: first")
+	assert.ErrorIs(t, err, err1)
+	assert.ErrorIs(t, err, err2)
+	assert.Equal(t, []string{"second", "first"}, closed)
+}
+
+func TestGomodular_Close_Respects_Context_Deadline(t *testing.T) {
+	c := gomodular.New()
+	var closed []string
+
+	err := c.Singleton(func() Shape {
+		return &Server{closed: &closed, wait: time.Second}
+	})
+	assert.NoError(t, err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	err = c.Close(ctx)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Empty(t, closed)
+}
+
+func TestGomodular_Close_Scope(t *testing.T) {
+	c := gomodular.New()
+	var closed []string
+
+	err := c.Scoped(func() Database {
+		return &Pool{name: "scoped", closed: &closed}
+	})
+	assert.NoError(t, err)
+
+	scope := c.Scope()
+
+	var db Database
+	assert.NoError(t, scope.Resolve(&db))
+
+	assert.NoError(t, c.Close(context.Background()))
+	assert.Empty(t, closed)
+
+	assert.NoError(t, scope.Close(context.Background()))
+	assert.Equal(t, []string{"scoped"}, closed)
+}
+
+type LoggedDatabase struct {
+	Database
+}
+
+func TestGomodular_Close_Decorated_Instances(t *testing.T) {
+	c := gomodular.New()
+	var closed []string
+
+	err := c.SingletonLazy(func() Database {
+		return &Pool{name: "lazy", closed: &closed}
+	})
+	assert.NoError(t, err)
+
+	err = c.Decorate(func(inner Database) Database {
+		return &LoggedDatabase{inner}
+	})
+	assert.NoError(t, err)
+
+	err = c.Decorate(func(inner Database) Database {
+		return inner
+	})
+	assert.NoError(t, err)
+
+	var db Database
+	assert.NoError(t, c.Resolve(&db))
+
+	err = c.NamedSingleton("eager", func() Database {
+		return &Pool{name: "eager", closed: &closed}
+	})
+	assert.NoError(t, err)
+
+	err = c.NamedDecorate("eager", func(inner Database) Database {
+		return &Pool{name: "wrapper", closed: &closed}
+	})
+	assert.NoError(t, err)
+
+	assert.NoError(t, c.Close(context.Background()))
+	assert.Equal(t, []string{"wrapper", "eager", "lazy"}, closed)
+}
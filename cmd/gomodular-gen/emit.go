@@ -0,0 +1,251 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/printer"
+	"go/types"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+type emitter struct {
+	pkg     *pkg
+	imports map[string]string
+	paths   map[string][]string
+	errors  bool
+	body    bytes.Buffer
+}
+
+func newEmitter(p *pkg) *emitter {
+	e := &emitter{pkg: p, imports: make(map[string]string), paths: make(map[string][]string)}
+	e.use("context", "context")
+	return e
+}
+
+func (e *emitter) use(name, path string) bool {
+	if existing, taken := e.imports[name]; taken {
+		return existing == path
+	}
+
+	e.imports[name] = path
+	e.paths[path] = append(e.paths[path], name)
+	return true
+}
+
+func (e *emitter) qualifier(p *types.Package) string {
+	if p == e.pkg.types {
+		return ""
+	}
+	if names := e.paths[p.Path()]; len(names) > 0 {
+		return names[0]
+	}
+
+	name := p.Name()
+	for i := 2; !e.use(name, p.Path()); i++ {
+		name = p.Name() + strconv.Itoa(i)
+	}
+	return name
+}
+
+func (e *emitter) expressions(plan *plan) {
+	for _, n := range append(append([]*node{}, plan.order...), plan.invokes...) {
+		ast.Inspect(n.function.expr, func(node ast.Node) bool {
+			if ident, ok := node.(*ast.Ident); ok {
+				if name, ok := e.pkg.info.Uses[ident].(*types.PkgName); ok {
+					e.use(name.Name(), name.Imported().Path())
+				}
+			}
+			return true
+		})
+	}
+}
+
+func (e *emitter) container(plan *plan) {
+	typeName, constructor := plan.module.variable+"Container", "New"+exported(plan.module.variable)+"Container"
+	if !ast.IsExported(plan.module.variable) {
+		constructor = "new" + exported(plan.module.variable) + "Container"
+	}
+
+	used := make(map[*provider]bool)
+	for _, n := range append(append([]*node{}, plan.order...), plan.invokes...) {
+		for _, dep := range n.deps {
+			used[dep] = true
+		}
+	}
+
+	var fields []string
+	names := make(map[*provider]string)
+	taken := make(map[string]bool)
+	for _, n := range plan.order {
+		if n.provider.private {
+			continue
+		}
+
+		base := fieldName(n.provider.out, n.provider.name)
+		name := base
+		for i := 2; taken[name]; i++ {
+			name = base + strconv.Itoa(i)
+		}
+		taken[name] = true
+		names[n.provider] = name
+		fields = append(fields, name)
+		used[n.provider] = true
+	}
+
+	fmt.Fprintf(&e.body, "type %s struct {\n", typeName)
+	for _, n := range plan.order {
+		if name, exist := names[n.provider]; exist {
+			fmt.Fprintf(&e.body, "\t%s %s\n", name, types.TypeString(n.provider.out, e.qualifier))
+		}
+	}
+	fmt.Fprintf(&e.body, "}\n\n")
+
+	fmt.Fprintf(&e.body, "func %s(ctx context.Context) (*%s, error) {\n", constructor, typeName)
+
+	vars := make(map[*provider]string)
+	for i, n := range plan.order {
+		v := "v" + strconv.Itoa(i)
+		vars[n.provider] = v
+		call := e.call(n, vars)
+
+		if !n.function.errors {
+			if used[n.provider] {
+				fmt.Fprintf(&e.body, "\t%s := %s\n", v, call)
+			} else {
+				fmt.Fprintf(&e.body, "\t_ = %s\n", call)
+			}
+			continue
+		}
+
+		e.errors = true
+		if used[n.provider] {
+			fmt.Fprintf(&e.body, "\t%s, err := %s\n\tif err != nil {\n", v, call)
+		} else {
+			fmt.Fprintf(&e.body, "\tif _, err := %s; err != nil {\n", call)
+		}
+		fmt.Fprintf(&e.body, "\t\treturn nil, fmt.Errorf(\"gomodular: resolver for %%s failed: %%w\", %q, err)\n\t}\n", typeString(n.provider.out))
+	}
+
+	for _, n := range plan.invokes {
+		call := e.call(n, vars)
+		if !n.function.errors {
+			fmt.Fprintf(&e.body, "\t%s\n", call)
+			continue
+		}
+
+		e.errors = true
+		fmt.Fprintf(&e.body, "\tif err := %s; err != nil {\n", call)
+		fmt.Fprintf(&e.body, "\t\treturn nil, fmt.Errorf(\"gomodular: module %%q failed: %%w\", %q, err)\n\t}\n", n.module.name)
+	}
+
+	fmt.Fprintf(&e.body, "\n\treturn &%s{\n", typeName)
+	for _, n := range plan.order {
+		if name, exist := names[n.provider]; exist {
+			fmt.Fprintf(&e.body, "\t\t%s: %s,\n", name, vars[n.provider])
+		}
+	}
+	fmt.Fprintf(&e.body, "\t}, nil\n}\n\n")
+}
+
+func (e *emitter) call(n *node, vars map[*provider]string) string {
+	var buf bytes.Buffer
+	_ = printer.Fprint(&buf, e.pkg.fset, n.function.expr)
+
+	var args []string
+	i := 0
+	for _, p := range n.function.params {
+		switch {
+		case p.ctx:
+			args = append(args, "ctx")
+		case p.in == nil:
+			args = append(args, vars[n.deps[i]])
+			i++
+		default:
+			var fields []string
+			for _, f := range p.fields {
+				if dep := n.deps[i]; dep != nil {
+					fields = append(fields, f.name+": "+vars[dep])
+				}
+				i++
+			}
+			args = append(args, types.TypeString(p.in, e.qualifier)+"{"+strings.Join(fields, ", ")+"}")
+		}
+	}
+
+	return buf.String() + "(" + strings.Join(args, ", ") + ")"
+}
+
+func (e *emitter) source() ([]byte, error) {
+	if e.errors {
+		e.use("fmt", "fmt")
+	}
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "// Code generated by gomodular-gen. DO NOT EDIT.\n\npackage %s\n\nimport (\n", e.pkg.types.Name())
+
+	names := make([]string, 0, len(e.imports))
+	for name := range e.imports {
+		names = append(names, name)
+	}
+	sort.Slice(names, func(i, j int) bool {
+		return e.imports[names[i]] < e.imports[names[j]] || (e.imports[names[i]] == e.imports[names[j]] && names[i] < names[j])
+	})
+	for _, name := range names {
+		path := e.imports[name]
+		if name == path[strings.LastIndex(path, "/")+1:] {
+			fmt.Fprintf(&buf, "\t%q\n", path)
+		} else {
+			fmt.Fprintf(&buf, "\t%s %q\n", name, path)
+		}
+	}
+	fmt.Fprintf(&buf, ")\n\n")
+	buf.Write(e.body.Bytes())
+
+	return format.Source(buf.Bytes())
+}
+
+func typeString(t types.Type) string {
+	return types.TypeString(t, func(p *types.Package) string {
+		return p.Name()
+	})
+}
+
+func fieldName(t types.Type, name string) string {
+	var base string
+	switch t := t.(type) {
+	case *types.Pointer:
+		return fieldName(t.Elem(), name)
+	case *types.Named:
+		base = t.Obj().Name()
+	case *types.Slice:
+		base = fieldName(t.Elem(), "") + "s"
+	case *types.Map:
+		base = fieldName(t.Elem(), "") + "Map"
+	case *types.Basic:
+		base = t.Name()
+	case *types.Signature:
+		base = "Func"
+	default:
+		base = "Value"
+	}
+
+	base = exported(base)
+	for _, part := range strings.FieldsFunc(name, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	}) {
+		base += exported(part)
+	}
+	return base
+}
+
+func exported(name string) string {
+	for i, r := range name {
+		return string(unicode.ToUpper(r)) + name[i+len(string(r)):]
+	}
+	return name
+}
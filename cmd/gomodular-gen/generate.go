@@ -0,0 +1,563 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"go/ast"
+	"go/constant"
+	"go/token"
+	"go/types"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/krishpranav/gomodular/internal/structtag"
+)
+
+const gomodularPath = "github.com/krishpranav/gomodular"
+
+type key struct {
+	typ  string
+	name string
+}
+
+type dependency struct {
+	typ      types.Type
+	name     string
+	optional bool
+}
+
+func (d dependency) key() key {
+	return key{typ: types.TypeString(d.typ, nil), name: d.name}
+}
+
+func (d dependency) String() string {
+	if d.name != "" {
+		return d.typ.String() + " named " + strconv.Quote(d.name)
+	}
+	return d.typ.String()
+}
+
+type field struct {
+	name string
+	dep  dependency
+}
+
+type param struct {
+	ctx    bool
+	dep    dependency
+	in     types.Type
+	fields []field
+}
+
+type function struct {
+	expr   ast.Expr
+	params []param
+	errors bool
+}
+
+type provider struct {
+	function
+	module  *module
+	out     types.Type
+	name    string
+	private bool
+}
+
+func (p *provider) dependency() dependency {
+	return dependency{typ: p.out, name: p.name}
+}
+
+type module struct {
+	variable  string
+	name      string
+	pos       token.Pos
+	imports   []*module
+	providers []*provider
+	invokes   []*function
+}
+
+type generator struct {
+	pkg     *pkg
+	decls   map[types.Object]ast.Expr
+	modules map[types.Object]*module
+	errs    []diagnostic
+}
+
+type diagnostic struct {
+	pos     token.Pos
+	message string
+}
+
+func generate(dir, output string, names []string) ([]byte, error) {
+	p, err := load(dir, output, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	g := &generator{pkg: p, decls: make(map[types.Object]ast.Expr), modules: make(map[types.Object]*module)}
+
+	var roots []types.Object
+	for _, file := range p.files {
+		for _, decl := range file.Decls {
+			gen, ok := decl.(*ast.GenDecl)
+			if !ok || gen.Tok != token.VAR {
+				continue
+			}
+			for _, spec := range gen.Specs {
+				spec := spec.(*ast.ValueSpec)
+				for i, ident := range spec.Names {
+					obj := p.info.Defs[ident]
+					if obj == nil || !isModule(obj.Type()) || i >= len(spec.Values) {
+						continue
+					}
+					g.decls[obj] = spec.Values[i]
+					roots = append(roots, obj)
+				}
+			}
+		}
+	}
+
+	if names != nil {
+		var selected []types.Object
+		for _, name := range names {
+			obj := p.types.Scope().Lookup(strings.TrimSpace(name))
+			if _, declared := g.decls[obj]; obj == nil || !declared {
+				return nil, fmt.Errorf("gomodular-gen: %s is not a gomodular.Module variable", name)
+			}
+			selected = append(selected, obj)
+		}
+		roots = selected
+	}
+	if len(roots) == 0 {
+		return nil, fmt.Errorf("gomodular-gen: no gomodular.Module variables found in %s", dir)
+	}
+
+	var plans []*plan
+	for _, root := range roots {
+		m := g.module(root)
+		if m == nil {
+			continue
+		}
+		if order, invokes, ok := g.resolve(m); ok {
+			plans = append(plans, &plan{module: m, order: order, invokes: invokes})
+		}
+	}
+
+	if len(g.errs) > 0 {
+		sort.SliceStable(g.errs, func(i, j int) bool {
+			return g.errs[i].pos < g.errs[j].pos
+		})
+
+		messages := make([]string, len(g.errs))
+		for i, d := range g.errs {
+			messages[i] = fmt.Sprintf("%s: gomodular-gen: %s", g.pkg.fset.Position(d.pos), d.message)
+		}
+		return nil, errors.New(strings.Join(messages, "\n"))
+	}
+
+	e := newEmitter(p)
+	for _, plan := range plans {
+		e.expressions(plan)
+	}
+	for _, plan := range plans {
+		e.container(plan)
+	}
+
+	return e.source()
+}
+
+func (g *generator) errorf(pos token.Pos, format string, args ...interface{}) {
+	g.errs = append(g.errs, diagnostic{pos: pos, message: fmt.Sprintf(format, args...)})
+}
+
+func (g *generator) module(obj types.Object) *module {
+	if m, exist := g.modules[obj]; exist {
+		return m
+	}
+
+	expr := g.decls[obj]
+	m := &module{variable: obj.Name(), name: obj.Name(), pos: expr.Pos()}
+	g.modules[obj] = m
+
+	if unary, ok := expr.(*ast.UnaryExpr); ok && unary.Op == token.AND {
+		expr = unary.X
+	}
+	lit, ok := expr.(*ast.CompositeLit)
+	if !ok {
+		g.errorf(expr.Pos(), "%s must be declared with a gomodular.Module literal", obj.Name())
+		return nil
+	}
+
+	for _, elt := range lit.Elts {
+		kv, ok := elt.(*ast.KeyValueExpr)
+		if !ok {
+			g.errorf(elt.Pos(), "%s must use keyed fields", obj.Name())
+			return nil
+		}
+
+		switch kv.Key.(*ast.Ident).Name {
+		case "Name":
+			if name, ok := g.constant(kv.Value); ok {
+				m.name = constant.StringVal(name)
+			}
+		case "Imports":
+			for _, imported := range g.elements(kv.Value) {
+				ident, ok := imported.(*ast.Ident)
+				if !ok || g.decls[g.pkg.info.Uses[ident]] == nil {
+					g.errorf(imported.Pos(), "imports must name gomodular.Module variables of this package")
+					continue
+				}
+				if dep := g.module(g.pkg.info.Uses[ident]); dep != nil {
+					m.imports = append(m.imports, dep)
+				}
+			}
+		case "Providers":
+			for _, elt := range g.elements(kv.Value) {
+				if p := g.provider(m, elt); p != nil {
+					m.providers = append(m.providers, p)
+				}
+			}
+		case "Invokes":
+			for _, elt := range g.elements(kv.Value) {
+				if f := g.function(elt, false); f != nil {
+					m.invokes = append(m.invokes, f)
+				}
+			}
+		}
+	}
+
+	return m
+}
+
+func (g *generator) elements(expr ast.Expr) []ast.Expr {
+	lit, ok := expr.(*ast.CompositeLit)
+	if !ok {
+		g.errorf(expr.Pos(), "expected a slice literal")
+		return nil
+	}
+	return lit.Elts
+}
+
+func (g *generator) constant(expr ast.Expr) (constant.Value, bool) {
+	value := g.pkg.info.Types[expr].Value
+	if value == nil {
+		g.errorf(expr.Pos(), "expected a constant")
+		return nil, false
+	}
+	return value, true
+}
+
+func (g *generator) provider(m *module, expr ast.Expr) *provider {
+	lit, ok := expr.(*ast.CompositeLit)
+	if !ok {
+		g.errorf(expr.Pos(), "expected a gomodular.Provider literal")
+		return nil
+	}
+
+	p := &provider{module: m}
+	var resolver ast.Expr
+	for _, elt := range lit.Elts {
+		kv, ok := elt.(*ast.KeyValueExpr)
+		if !ok {
+			g.errorf(elt.Pos(), "gomodular.Provider literals must use keyed fields")
+			return nil
+		}
+
+		switch kv.Key.(*ast.Ident).Name {
+		case "Resolver":
+			resolver = kv.Value
+		case "Name":
+			if name, ok := g.constant(kv.Value); ok {
+				p.name = constant.StringVal(name)
+			}
+		case "Private":
+			if private, ok := g.constant(kv.Value); ok {
+				p.private = constant.BoolVal(private)
+			}
+		case "Lifetime":
+			if lifetime, ok := g.constant(kv.Value); ok {
+				if l := constant.StringVal(lifetime); l != "" && l != "singleton" {
+					g.errorf(kv.Value.Pos(), "lifetime %q is not supported, generated containers only build singletons", l)
+				}
+			}
+		case "Lazy":
+			if lazy, ok := g.constant(kv.Value); ok && constant.BoolVal(lazy) {
+				g.errorf(kv.Value.Pos(), "lazy providers are not supported, generated containers build every provider up front")
+			}
+		}
+	}
+
+	if resolver == nil {
+		g.errorf(lit.Pos(), "provider has no resolver")
+		return nil
+	}
+
+	f := g.function(resolver, true)
+	if f == nil {
+		return nil
+	}
+	p.function = *f
+	p.out = g.pkg.info.TypeOf(resolver).Underlying().(*types.Signature).Results().At(0).Type()
+
+	return p
+}
+
+func (g *generator) function(expr ast.Expr, resolver bool) *function {
+	sig, ok := g.pkg.info.TypeOf(expr).Underlying().(*types.Signature)
+	if !ok {
+		g.errorf(expr.Pos(), "%s must be a function", g.source(expr))
+		return nil
+	}
+	if sig.Variadic() {
+		g.errorf(expr.Pos(), "%s must not be variadic", g.source(expr))
+		return nil
+	}
+
+	results := sig.Results()
+	f := &function{expr: expr}
+	if resolver {
+		if results.Len() == 0 || results.Len() > 2 || (results.Len() == 2 && !isError(results.At(1).Type())) || isError(results.At(0).Type()) {
+			g.errorf(expr.Pos(), "resolver %s must return abstract, or abstract and error", g.source(expr))
+			return nil
+		}
+		f.errors = results.Len() == 2
+	} else {
+		if results.Len() > 1 || (results.Len() == 1 && !isError(results.At(0).Type())) {
+			g.errorf(expr.Pos(), "invocation %s must return nothing or an error", g.source(expr))
+			return nil
+		}
+		f.errors = results.Len() == 1
+	}
+
+	for i := 0; i < sig.Params().Len(); i++ {
+		t := sig.Params().At(i).Type()
+		switch {
+		case isNamed(t, "context", "Context"):
+			f.params = append(f.params, param{ctx: true})
+		case isNamed(t, gomodularPath, "Lifecycle"), isNamed(t, gomodularPath, "Optional"):
+			g.errorf(expr.Pos(), "%s takes a %s, which generated containers do not support", g.source(expr), t)
+			return nil
+		case isIn(t):
+			p, ok := g.in(expr, t)
+			if !ok {
+				return nil
+			}
+			f.params = append(f.params, p)
+		default:
+			f.params = append(f.params, param{dep: dependency{typ: t}})
+		}
+	}
+
+	return f
+}
+
+func (g *generator) in(expr ast.Expr, t types.Type) (param, bool) {
+	p := param{in: t}
+	st := t.Underlying().(*types.Struct)
+	for i := 0; i < st.NumFields(); i++ {
+		f := st.Field(i)
+		if f.Embedded() && isNamed(f.Type(), gomodularPath, "In") {
+			continue
+		}
+
+		value, exist := reflect.StructTag(st.Tag(i)).Lookup("gomodular")
+		if !exist {
+			continue
+		}
+
+		tag, err := structtag.Parse(f.Name(), value)
+		if err != nil {
+			g.errorf(f.Pos(), "%s has an invalid struct tag: %s", f.Name(), err)
+			return param{}, false
+		}
+		if tag.Lazy {
+			g.errorf(f.Pos(), "lazy field %s is not supported by generated containers", f.Name())
+			return param{}, false
+		}
+		if !f.Exported() && f.Pkg() != g.pkg.types {
+			g.errorf(expr.Pos(), "%s has an unexported field %s of another package", t, f.Name())
+			return param{}, false
+		}
+
+		p.fields = append(p.fields, field{name: f.Name(), dep: dependency{typ: f.Type(), name: tag.Name, optional: tag.Optional}})
+	}
+
+	return p, true
+}
+
+func (g *generator) source(expr ast.Expr) string {
+	if lit, ok := expr.(*ast.FuncLit); ok {
+		return types.ExprString(lit.Type)
+	}
+	return types.ExprString(expr)
+}
+
+type plan struct {
+	module  *module
+	order   []*node
+	invokes []*node
+}
+
+type node struct {
+	function *function
+	module   *module
+	provider *provider
+	deps     []*provider
+}
+
+func (g *generator) resolve(root *module) ([]*node, []*node, bool) {
+	var modules []*module
+	seen := make(map[*module]bool)
+	var install func(m *module)
+	install = func(m *module) {
+		if seen[m] {
+			return
+		}
+		seen[m] = true
+		for _, imported := range m.imports {
+			install(imported)
+		}
+		modules = append(modules, m)
+	}
+	install(root)
+
+	failed := len(g.errs)
+	exported := make(map[key]*provider)
+	private := make(map[*module]map[key]*provider)
+	for _, m := range modules {
+		private[m] = make(map[key]*provider)
+		for _, p := range m.providers {
+			scope := exported
+			if p.private {
+				scope = private[m]
+			}
+			if existing, exist := scope[p.dependency().key()]; exist {
+				g.errorf(p.expr.Pos(), "%s is already provided at %s", p.dependency(), g.pkg.fset.Position(existing.expr.Pos()))
+				continue
+			}
+			scope[p.dependency().key()] = p
+		}
+	}
+
+	lookup := func(m *module, d dependency) *provider {
+		if p, exist := private[m][d.key()]; exist {
+			return p
+		}
+		return exported[d.key()]
+	}
+
+	targets := func(m *module, f *function) []*provider {
+		var deps []*provider
+		for _, param := range f.params {
+			var wanted []dependency
+			if param.in != nil {
+				for _, field := range param.fields {
+					wanted = append(wanted, field.dep)
+				}
+			} else if !param.ctx {
+				wanted = append(wanted, param.dep)
+			}
+
+			for _, d := range wanted {
+				target := lookup(m, d)
+				if target == nil && !d.optional {
+					g.errorf(f.expr.Pos(), "no provider found for %s required by %s", d, g.source(f.expr))
+				}
+				deps = append(deps, target)
+			}
+		}
+		return deps
+	}
+
+	const (
+		unvisited = iota
+		visiting
+		visited
+	)
+	state := make(map[*provider]int)
+	var order []*node
+	var stack []*provider
+
+	var visit func(p *provider)
+	visit = func(p *provider) {
+		state[p] = visiting
+		stack = append(stack, p)
+
+		n := &node{function: &p.function, module: p.module, provider: p, deps: targets(p.module, &p.function)}
+		for _, dep := range n.deps {
+			if dep == nil {
+				continue
+			}
+			switch state[dep] {
+			case unvisited:
+				visit(dep)
+			case visiting:
+				var path []string
+				for i := len(stack) - 1; i >= 0; i-- {
+					if stack[i] == dep {
+						for _, member := range stack[i:] {
+							path = append(path, member.out.String())
+						}
+						break
+					}
+				}
+				g.errorf(p.expr.Pos(), "circular dependency detected: %s", strings.Join(append(path, dep.out.String()), " -> "))
+			}
+		}
+
+		stack = stack[:len(stack)-1]
+		state[p] = visited
+		order = append(order, n)
+	}
+
+	for _, m := range modules {
+		for _, p := range m.providers {
+			if state[p] == unvisited {
+				visit(p)
+			}
+		}
+	}
+
+	var invokes []*node
+	for _, m := range modules {
+		for _, f := range m.invokes {
+			invokes = append(invokes, &node{function: f, module: m, deps: targets(m, f)})
+		}
+	}
+
+	return order, invokes, len(g.errs) == failed
+}
+
+func isModule(t types.Type) bool {
+	if ptr, ok := t.(*types.Pointer); ok {
+		t = ptr.Elem()
+	}
+	return isNamed(t, gomodularPath, "Module")
+}
+
+func isNamed(t types.Type, path, name string) bool {
+	named, ok := t.(*types.Named)
+	if !ok || named.Obj().Pkg() == nil {
+		return false
+	}
+	return named.Obj().Pkg().Path() == path && named.Obj().Name() == name
+}
+
+func isError(t types.Type) bool {
+	return types.Identical(t, types.Universe.Lookup("error").Type())
+}
+
+func isIn(t types.Type) bool {
+	st, ok := t.Underlying().(*types.Struct)
+	if !ok {
+		return false
+	}
+	for i := 0; i < st.NumFields(); i++ {
+		if st.Field(i).Embedded() && isNamed(st.Field(i).Type(), gomodularPath, "In") {
+			return true
+		}
+	}
+	return false
+}
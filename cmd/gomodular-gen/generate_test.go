@@ -0,0 +1,73 @@
+package main
+
+import (
+	"flag"
+	"go/token"
+	"go/types"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var update = flag.Bool("update", false, "update golden files")
+
+func TestGenerate(t *testing.T) {
+	output := filepath.Join("testdata", "app", "gomodular_gen.go")
+
+	src, err := generate(filepath.Join("testdata", "app"), output, nil)
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	golden := filepath.Join("testdata", "app", "gomodular_gen.go.golden")
+	if *update {
+		assert.NoError(t, os.WriteFile(golden, src, 0o644))
+	}
+
+	expected, err := os.ReadFile(golden)
+	assert.NoError(t, err)
+	assert.Equal(t, string(expected), string(src))
+
+	_, err = load(filepath.Join("testdata", "app"), output, src)
+	assert.NoError(t, err)
+}
+
+func TestGenerate_Selected_Modules(t *testing.T) {
+	output := filepath.Join("testdata", "app", "gomodular_gen.go")
+
+	src, err := generate(filepath.Join("testdata", "app"), output, []string{"Storage"})
+	assert.NoError(t, err)
+	assert.Contains(t, string(src), "func NewStorageContainer(ctx context.Context) (*StorageContainer, error) {")
+	assert.NotContains(t, string(src), "AppContainer")
+
+	_, err = generate(filepath.Join("testdata", "app"), output, []string{"NewServer"})
+	assert.EqualError(t, err, "gomodular-gen: NewServer is not a gomodular.Module variable")
+}
+
+func TestGenerate_Reports_Missing_Bindings_And_Cycles(t *testing.T) {
+	_, err := generate(filepath.Join("testdata", "broken"), filepath.Join("testdata", "broken", "gomodular_gen.go"), nil)
+	if !assert.Error(t, err) {
+		return
+	}
+
+	lines := strings.Split(err.Error(), "\n")
+	if assert.Len(t, lines, 4) {
+		assert.Equal(t, "testdata/broken/broken.go:15:14: gomodular-gen: circular dependency detected: broken.Database -> broken.Cache -> broken.Database", lines[0])
+		assert.Equal(t, "testdata/broken/broken.go:16:14: gomodular-gen: no provider found for string required by func(db Database, s string) *Server", lines[1])
+		assert.Equal(t, "testdata/broken/broken.go:17:49: gomodular-gen: lifetime \"transient\" is not supported, generated containers only build singletons", lines[2])
+		assert.Equal(t, "testdata/broken/broken.go:18:71: gomodular-gen: lazy providers are not supported, generated containers build every provider up front", lines[3])
+	}
+}
+
+func TestFieldName(t *testing.T) {
+	server := types.NewNamed(types.NewTypeName(token.NoPos, nil, "server", nil), types.NewStruct(nil, nil), nil)
+
+	assert.Equal(t, "Server", fieldName(types.NewPointer(server), ""))
+	assert.Equal(t, "ServerPostgresPrimary", fieldName(server, "postgres-primary"))
+	assert.Equal(t, "Servers", fieldName(types.NewSlice(server), ""))
+	assert.Equal(t, "ServerMap", fieldName(types.NewMap(types.Typ[types.String], server), ""))
+	assert.Equal(t, "String", fieldName(types.Typ[types.String], ""))
+}
@@ -0,0 +1,74 @@
+package main
+
+import (
+	"errors"
+	"go/ast"
+	"go/build"
+	"go/importer"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"path/filepath"
+	"strings"
+)
+
+type pkg struct {
+	fset  *token.FileSet
+	files []*ast.File
+	types *types.Package
+	info  *types.Info
+}
+
+func load(dir, output string, overlay []byte) (*pkg, error) {
+	bp, err := build.ImportDir(dir, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &pkg{fset: token.NewFileSet()}
+	for _, name := range bp.GoFiles {
+		path := filepath.Join(dir, name)
+		if filepath.Clean(path) == filepath.Clean(output) {
+			continue
+		}
+
+		file, err := parser.ParseFile(p.fset, path, nil, parser.ParseComments)
+		if err != nil {
+			return nil, err
+		}
+		p.files = append(p.files, file)
+	}
+	if overlay != nil {
+		file, err := parser.ParseFile(p.fset, output, overlay, parser.ParseComments)
+		if err != nil {
+			return nil, err
+		}
+		p.files = append(p.files, file)
+	}
+
+	p.info = &types.Info{
+		Types: make(map[ast.Expr]types.TypeAndValue),
+		Defs:  make(map[*ast.Ident]types.Object),
+		Uses:  make(map[*ast.Ident]types.Object),
+	}
+
+	var errs []string
+	conf := types.Config{
+		Importer: importer.ForCompiler(p.fset, "source", nil),
+		Error: func(err error) {
+			errs = append(errs, err.Error())
+		},
+	}
+
+	path := bp.ImportPath
+	if path == "" || path == "." {
+		path = bp.Name
+	}
+
+	p.types, _ = conf.Check(path, p.fset, p.files, p.info)
+	if len(errs) > 0 {
+		return nil, errors.New(strings.Join(errs, "\n"))
+	}
+
+	return p, nil
+}
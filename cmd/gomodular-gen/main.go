@@ -0,0 +1,47 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+func main() {
+	output := flag.String("o", "gomodular_gen.go", "output file, relative to the package directory unless absolute")
+	modules := flag.String("module", "", "comma separated module variables to generate, all of them by default")
+	flag.Usage = func() {
+		fmt.Fprintln(os.Stderr, "usage: gomodular-gen [-o file] [-module names] [dir]")
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+
+	dir := "."
+	if flag.NArg() > 0 {
+		dir = flag.Arg(0)
+	}
+
+	var names []string
+	if *modules != "" {
+		names = strings.Split(*modules, ",")
+	}
+
+	if err := run(dir, *output, names); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+func run(dir, output string, names []string) error {
+	if !filepath.IsAbs(output) {
+		output = filepath.Join(dir, output)
+	}
+
+	src, err := generate(dir, output, names)
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(output, src, 0o644)
+}
@@ -0,0 +1,88 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/krishpranav/gomodular"
+)
+
+type Config struct {
+	DSN string
+}
+
+type Database interface {
+	Ping(ctx context.Context) error
+}
+
+type pool struct {
+	dsn string
+}
+
+func (p *pool) Ping(ctx context.Context) error {
+	if p.dsn == "" {
+		return errors.New("app: empty dsn")
+	}
+	return ctx.Err()
+}
+
+type Server struct {
+	Primary Database
+	Replica Database
+	Log     *strings.Builder
+}
+
+type ServerParams struct {
+	gomodular.In
+
+	Primary Database         `gomodular:"name=primary"`
+	Replica Database         `gomodular:"name=replica,optional"`
+	Log     *strings.Builder `gomodular:"type"`
+}
+
+func NewConfig() *Config {
+	return &Config{DSN: "postgres://localhost"}
+}
+
+func newPool(cfg *Config) *pool {
+	return &pool{dsn: cfg.DSN}
+}
+
+func NewDatabase(ctx context.Context, p *pool) (Database, error) {
+	return p, p.Ping(ctx)
+}
+
+func NewServer(params ServerParams) *Server {
+	return &Server{Primary: params.Primary, Replica: params.Replica, Log: params.Log}
+}
+
+var ConfigModule = &gomodular.Module{
+	Name: "config",
+	Providers: []gomodular.Provider{
+		{Resolver: NewConfig},
+	},
+}
+
+var Storage = &gomodular.Module{
+	Name:    "storage",
+	Imports: []*gomodular.Module{ConfigModule},
+	Providers: []gomodular.Provider{
+		{Resolver: newPool, Private: true},
+		{Resolver: NewDatabase, Name: "primary", Lifetime: gomodular.LifetimeSingleton},
+	},
+}
+
+var App = &gomodular.Module{
+	Name:    "app",
+	Imports: []*gomodular.Module{Storage},
+	Providers: []gomodular.Provider{
+		{Resolver: NewServer},
+		{Resolver: func() *strings.Builder { return &strings.Builder{} }, Lazy: false},
+	},
+	Invokes: []interface{}{
+		func(ctx context.Context, s *Server) error {
+			return s.Primary.Ping(ctx)
+		},
+	},
+}
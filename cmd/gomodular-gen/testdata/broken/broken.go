@@ -0,0 +1,20 @@
+package broken
+
+import "github.com/krishpranav/gomodular"
+
+type Database interface{}
+
+type Cache interface{}
+
+type Server struct{}
+
+var Broken = &gomodular.Module{
+	Name: "broken",
+	Providers: []gomodular.Provider{
+		{Resolver: func(c Cache) Database { return nil }},
+		{Resolver: func(db Database) Cache { return nil }},
+		{Resolver: func(db Database, s string) *Server { return &Server{} }},
+		{Resolver: func() int { return 1 }, Lifetime: gomodular.LifetimeTransient},
+		{Resolver: func() *Server { return &Server{} }, Name: "lazy", Lazy: true},
+	},
+}
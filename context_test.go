@@ -0,0 +1,113 @@
+package gomodular_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/krishpranav/gomodular"
+	"github.com/stretchr/testify/assert"
+)
+
+type ctxKey struct{}
+
+func TestGomodular_ResolveContext_Passes_Context(t *testing.T) {
+	c := gomodular.New()
+
+	err := c.TransientLazy(func(ctx context.Context) Shape {
+		return &Circle{a: ctx.Value(ctxKey{}).(int)}
+	})
+	assert.NoError(t, err)
+
+	ctx := context.WithValue(context.Background(), ctxKey{}, 13)
+
+	var s Shape
+	err = c.ResolveContext(ctx, &s)
+	assert.NoError(t, err)
+	assert.Equal(t, 13, s.GetArea())
+
+	err = c.CallContext(ctx, func(ctx context.Context, s Shape) {
+		assert.Equal(t, 13, ctx.Value(ctxKey{}))
+		assert.Equal(t, 13, s.GetArea())
+	})
+	assert.NoError(t, err)
+
+	myApp := struct {
+		S Shape `gomodular:"type"`
+	}{}
+	err = c.FillContext(ctx, &myApp)
+	assert.NoError(t, err)
+	assert.Equal(t, 13, myApp.S.GetArea())
+}
+
+func TestGomodular_Resolve_Passes_Background_Context(t *testing.T) {
+	c := gomodular.New()
+
+	err := c.Singleton(func(ctx context.Context) Shape {
+		assert.NotNil(t, ctx)
+		return &Circle{}
+	})
+	assert.NoError(t, err)
+
+	err = c.Call(func(ctx context.Context) {
+		assert.NoError(t, ctx.Err())
+	})
+	assert.NoError(t, err)
+}
+
+func TestGomodular_ResolveContext_Stops_When_Cancelled(t *testing.T) {
+	c := gomodular.New()
+	ctx, cancel := context.WithCancel(context.Background())
+
+	built := false
+	err := c.SingletonLazy(func() Shape {
+		built = true
+		return &Circle{}
+	})
+	assert.NoError(t, err)
+
+	err = c.SingletonLazy(func(ctx context.Context) Database {
+		cancel()
+		return &MySQL{}
+	})
+	assert.NoError(t, err)
+
+	err = c.SingletonLazy(func(db Database, s Shape) Mailer {
+		return nil
+	})
+	assert.NoError(t, err)
+
+	var m Mailer
+	err = c.ResolveContext(ctx, &m)
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.False(t, built)
+
+	err = c.ResolveContext(context.Background(), &m)
+	assert.NoError(t, err)
+}
+
+func TestGomodular_CallContext_With_Cancelled_Context(t *testing.T) {
+	c := gomodular.New()
+
+	err := c.Singleton(func() Shape {
+		return &Circle{}
+	})
+	assert.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	called := false
+	err = c.CallContext(ctx, func(s Shape) {
+		called = true
+	})
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.False(t, called)
+
+	var s Shape
+	assert.ErrorIs(t, c.ResolveContext(ctx, &s), context.Canceled)
+
+	myApp := struct {
+		S Shape `gomodular:"type"`
+	}{}
+	assert.ErrorIs(t, c.FillContext(ctx, &myApp), context.Canceled)
+}
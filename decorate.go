@@ -0,0 +1,135 @@
+package gomodular
+
+import "reflect"
+
+type decorator struct {
+	function interface{}
+	inner    int
+}
+
+func (c *Gomodular) Decorate(decorator interface{}) error {
+	return c.NamedDecorate("", decorator)
+}
+
+func (c *Gomodular) NamedDecorate(name string, function interface{}) error {
+	reflectedDecorator := reflect.TypeOf(function)
+	if reflectedDecorator == nil || reflectedDecorator.Kind() != reflect.Func {
+		return &InvalidResolverError{Resolver: reflectedDecorator, Reason: "the decorator must be a function"}
+	}
+
+	retCount := reflectedDecorator.NumOut()
+	if retCount == 0 || retCount > 2 || (retCount == 2 && reflectedDecorator.Out(1) != errorType) {
+		return &InvalidResolverError{Resolver: reflectedDecorator, Reason: "decorator function signature is invalid - it must return abstract, or abstract and error"}
+	}
+
+	abstraction := reflectedDecorator.Out(0)
+	d := decorator{function: function, inner: -1}
+	for i := 0; i < reflectedDecorator.NumIn(); i++ {
+		if reflectedDecorator.In(i) != abstraction {
+			continue
+		}
+		if d.inner != -1 {
+			return &InvalidResolverError{Resolver: reflectedDecorator, Reason: "decorator function signature is invalid - it must take the abstract it returns once"}
+		}
+		d.inner = i
+	}
+	if d.inner == -1 {
+		return &InvalidResolverError{Resolver: reflectedDecorator, Reason: "decorator function signature is invalid - it must take the abstract it returns"}
+	}
+
+	c.mu.RLock()
+	b, exist := c.bindings[abstraction][name]
+	c.mu.RUnlock()
+	if !exist {
+		return (*resolution)(nil).notFound(abstraction, name)
+	}
+
+	if err := c.detectCycle(abstraction, name, d.dependencies()); err != nil {
+		return err
+	}
+
+	b.build.Lock()
+	defer b.build.Unlock()
+
+	b.mu.Lock()
+	concrete, built := b.concrete, b.isSingleton && b.instantiated
+	b.mu.Unlock()
+
+	if built {
+		r := &resolution{name: name, path: []reflect.Type{abstraction}, bindings: []*binding{b}}
+		chain, err := c.decorate(r, []decorator{d}, concrete)
+		if err != nil {
+			return err
+		}
+		concrete = chain[len(chain)-1]
+		c.track(chain[1:]...)
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if built {
+		b.concrete = concrete
+	}
+	b.decorators = append(append([]decorator{}, b.decorators...), d)
+
+	return nil
+}
+
+func (d decorator) dependencies() []dependency {
+	funcType := reflect.TypeOf(d.function)
+
+	var deps []dependency
+	for i := 0; i < funcType.NumIn(); i++ {
+		if i != d.inner {
+			deps = append(deps, dependencies(reflect.FuncOf([]reflect.Type{funcType.In(i)}, nil, false))...)
+		}
+	}
+
+	return deps
+}
+
+func (b *binding) dependencies() []dependency {
+	b.mu.Lock()
+	decorators := b.decorators
+	b.mu.Unlock()
+
+	deps := dependencies(reflect.TypeOf(b.resolver))
+	for _, d := range decorators {
+		deps = append(deps, d.dependencies()...)
+	}
+
+	return deps
+}
+
+func (c *Gomodular) decorate(r *resolution, decorators []decorator, instance interface{}) ([]interface{}, error) {
+	chain := []interface{}{instance}
+	for _, d := range decorators {
+		funcType := reflect.TypeOf(d.function)
+		arguments := make([]reflect.Value, funcType.NumIn())
+		for i := range arguments {
+			if i == d.inner {
+				arguments[i] = instanceValue(funcType.In(i), instance)
+				continue
+			}
+
+			argument, err := c.argument(r, funcType.In(i))
+			if err != nil {
+				return nil, err
+			}
+			arguments[i] = argument
+		}
+
+		values, err := c.call(reflect.ValueOf(d.function), arguments)
+		if err != nil {
+			return nil, r.failed(funcType.Out(0), err)
+		}
+		if len(values) == 2 && !values[1].IsNil() {
+			return nil, r.failed(funcType.Out(0), values[1].Interface().(error))
+		}
+		instance = values[0].Interface()
+		chain = append(chain, instance)
+	}
+
+	return chain, nil
+}
@@ -0,0 +1,152 @@
+package gomodular_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/krishpranav/gomodular"
+	"github.com/stretchr/testify/assert"
+)
+
+type ScaledShape struct {
+	Shape
+	factor int
+}
+
+func (s *ScaledShape) GetArea() int {
+	return s.Shape.GetArea() * s.factor
+}
+
+func TestGomodular_Decorate_Singleton(t *testing.T) {
+	c := gomodular.New()
+
+	err := c.Singleton(func() Shape {
+		return &Circle{a: 2}
+	})
+	assert.NoError(t, err)
+
+	err = c.Decorate(func(inner Shape) Shape {
+		return &ScaledShape{Shape: inner, factor: 3}
+	})
+	assert.NoError(t, err)
+
+	err = c.Decorate(func(db Database, inner Shape) (Shape, error) {
+		return &ScaledShape{Shape: inner, factor: 10}, nil
+	})
+	assert.ErrorIs(t, err, gomodular.ErrNotFound)
+
+	err = c.Singleton(func() Database {
+		return &MySQL{}
+	})
+	assert.NoError(t, err)
+
+	err = c.Decorate(func(db Database, inner Shape) (Shape, error) {
+		return &ScaledShape{Shape: inner, factor: 10}, nil
+	})
+	assert.NoError(t, err)
+
+	var s Shape
+	assert.NoError(t, c.Resolve(&s))
+	assert.Equal(t, 60, s.GetArea())
+}
+
+func TestGomodular_Decorate_Lazy_Singleton_And_Transient(t *testing.T) {
+	c := gomodular.New()
+
+	err := c.SingletonLazy(func() Shape {
+		return &Circle{a: 2}
+	})
+	assert.NoError(t, err)
+
+	err = c.NamedTransientLazy("rounded", func() Shape {
+		return &Circle{a: 5}
+	})
+	assert.NoError(t, err)
+
+	for _, factor := range []int{3, 10} {
+		factor := factor
+		decorator := func(inner Shape) Shape {
+			return &ScaledShape{Shape: inner, factor: factor}
+		}
+		assert.NoError(t, c.Decorate(decorator))
+		assert.NoError(t, c.NamedDecorate("rounded", decorator))
+	}
+
+	var s1, s2 Shape
+	assert.NoError(t, c.Resolve(&s1))
+	assert.NoError(t, c.Resolve(&s2))
+	assert.Equal(t, 60, s1.GetArea())
+	assert.Same(t, s1, s2)
+
+	assert.NoError(t, c.NamedResolve(&s1, "rounded"))
+	assert.NoError(t, c.NamedResolve(&s2, "rounded"))
+	assert.Equal(t, 150, s1.GetArea())
+	assert.NotSame(t, s1, s2)
+}
+
+func TestGomodular_Decorate_With_Failing_Decorator(t *testing.T) {
+	c := gomodular.New()
+
+	err := c.TransientLazy(func() Shape {
+		return &Circle{a: 2}
+	})
+	assert.NoError(t, err)
+
+	appErr := errors.New("app: error")
+	err = c.Decorate(func(inner Shape) (Shape, error) {
+		return nil, appErr
+	})
+	assert.NoError(t, err)
+
+	var s Shape
+	err = c.Resolve(&s)
+	assert.ErrorIs(t, err, appErr)
+	assert.ErrorIs(t, err, gomodular.ErrResolverFailed)
+}
+
+func TestGomodular_Decorate_With_Invalid_Decorator_It_Should_Fail(t *testing.T) {
+	c := gomodular.New()
+
+	err := c.Singleton(func() Shape {
+		return &Circle{a: 2}
+	})
+	assert.NoError(t, err)
+
+	err = c.Decorate("STRING!")
+	assert.EqualError(t, err, "gomodular: the decorator must be a function")
+
+	err = c.Decorate(func(inner Shape) {})
+	assert.EqualError(t, err, "gomodular: decorator function signature is invalid - it must return abstract, or abstract and error")
+
+	err = c.Decorate(func(inner Shape) (Shape, int) { return inner, 0 })
+	assert.EqualError(t, err, "gomodular: decorator function signature is invalid - it must return abstract, or abstract and error")
+
+	err = c.Decorate(func() Shape { return nil })
+	assert.EqualError(t, err, "gomodular: decorator function signature is invalid - it must take the abstract it returns")
+
+	err = c.Decorate(func(a Shape, b Shape) Shape { return a })
+	assert.EqualError(t, err, "gomodular: decorator function signature is invalid - it must take the abstract it returns once")
+	assert.ErrorIs(t, err, gomodular.ErrInvalidResolver)
+
+	err = c.NamedDecorate("rounded", func(inner Shape) Shape { return inner })
+	assert.ErrorIs(t, err, gomodular.ErrNotFound)
+}
+
+func TestGomodular_Decorate_With_Circular_Dependency_It_Should_Fail(t *testing.T) {
+	c := gomodular.New()
+
+	err := c.SingletonLazy(func() Shape {
+		return &Circle{a: 2}
+	})
+	assert.NoError(t, err)
+
+	err = c.SingletonLazy(func(s Shape) Database {
+		return &MySQL{}
+	})
+	assert.NoError(t, err)
+
+	err = c.Decorate(func(db Database, inner Shape) Shape {
+		return inner
+	})
+	assert.EqualError(t, err, "gomodular: circular dependency detected: gomodular_test.Shape -> gomodular_test.Database -> gomodular_test.Shape")
+}
@@ -0,0 +1,235 @@
+package gomodular
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+var (
+	ErrInvalidResolver    = errors.New("gomodular: invalid resolver")
+	ErrNotFound           = errors.New("gomodular: no concrete found")
+	ErrResolverFailed     = errors.New("gomodular: resolver failed")
+	ErrCircularDependency = errors.New("gomodular: circular dependency detected")
+	ErrInvalidFunction    = errors.New("gomodular: invalid function")
+	ErrInvalidReceiver    = errors.New("gomodular: receiver function signature is invalid")
+	ErrInvalidAbstraction = errors.New("gomodular: invalid abstraction")
+	ErrInvalidStructure   = errors.New("gomodular: invalid structure")
+	ErrInvalidTag         = errors.New("gomodular: invalid struct tag")
+	ErrLifetimeMismatch   = errors.New("gomodular: lifetime mismatch")
+	ErrAlreadyBound       = errors.New("gomodular: already bound")
+	ErrResolverPanicked   = errors.New("gomodular: resolver panicked")
+)
+
+type InvalidResolverError struct {
+	Resolver reflect.Type
+	Reason   string
+}
+
+func (e *InvalidResolverError) Error() string {
+	return "gomodular: " + e.Reason
+}
+
+func (e *InvalidResolverError) Is(target error) bool {
+	return target == ErrInvalidResolver
+}
+
+type NotFoundError struct {
+	Type   reflect.Type
+	Name   string
+	Path   []reflect.Type
+	Source string
+}
+
+func (e *NotFoundError) Error() string {
+	message := "gomodular: no concrete found for: " + e.Type.String()
+	if e.Name != "" {
+		message += " named " + strconv.Quote(e.Name)
+	}
+	if e.Source != "" {
+		message += " (required by resolver registered at " + e.Source + ")"
+	}
+	return message
+}
+
+func (e *NotFoundError) Is(target error) bool {
+	return target == ErrNotFound
+}
+
+type ResolverFailedError struct {
+	Type   reflect.Type
+	Name   string
+	Path   []reflect.Type
+	Source string
+	Err    error
+}
+
+func (e *ResolverFailedError) Error() string {
+	if e.Source != "" {
+		return "gomodular: resolver for " + formatPath(e.Path) + " (registered at " + e.Source + ") failed: " + e.Err.Error()
+	}
+	return "gomodular: resolver for " + formatPath(e.Path) + " failed: " + e.Err.Error()
+}
+
+func (e *ResolverFailedError) Is(target error) bool {
+	return target == ErrResolverFailed
+}
+
+func (e *ResolverFailedError) Unwrap() error {
+	return e.Err
+}
+
+type AlreadyBoundError struct {
+	Type   reflect.Type
+	Name   string
+	Source string
+}
+
+func (e *AlreadyBoundError) Error() string {
+	message := "gomodular: " + e.Type.String()
+	if e.Name != "" {
+		message += " named " + strconv.Quote(e.Name)
+	}
+	message += " is already bound"
+	if e.Source != "" {
+		message += " at " + e.Source
+	}
+	return message
+}
+
+func (e *AlreadyBoundError) Is(target error) bool {
+	return target == ErrAlreadyBound
+}
+
+type PanicError struct {
+	Value interface{}
+	Stack []byte
+}
+
+func (e *PanicError) Error() string {
+	return "gomodular: resolver panicked: " + fmt.Sprint(e.Value)
+}
+
+func (e *PanicError) Is(target error) bool {
+	return target == ErrResolverPanicked
+}
+
+type CycleError struct {
+	Path []reflect.Type
+}
+
+func (e *CycleError) Error() string {
+	return "gomodular: circular dependency detected: " + formatPath(e.Path)
+}
+
+func (e *CycleError) Is(target error) bool {
+	return target == ErrCircularDependency
+}
+
+type LifetimeError struct {
+	Type               reflect.Type
+	Name               string
+	Lifetime           string
+	Dependency         reflect.Type
+	DependencyName     string
+	DependencyLifetime string
+}
+
+func (e *LifetimeError) Error() string {
+	return "gomodular: " + e.Lifetime + " " + e.Type.String() + " depends on " + e.DependencyLifetime + " " + e.Dependency.String()
+}
+
+func (e *LifetimeError) Is(target error) bool {
+	return target == ErrLifetimeMismatch
+}
+
+type InvalidTagError struct {
+	Field  string
+	Tag    string
+	Reason string
+}
+
+func (e *InvalidTagError) Error() string {
+	return "gomodular: " + e.Field + " has an invalid struct tag: " + e.Reason
+}
+
+func (e *InvalidTagError) Is(target error) bool {
+	return target == ErrInvalidTag
+}
+
+type FieldError struct {
+	Field string
+	Err   error
+}
+
+func (e *FieldError) Error() string {
+	return "gomodular: cannot make " + e.Field + " field"
+}
+
+func (e *FieldError) Unwrap() error {
+	return e.Err
+}
+
+type ModuleError struct {
+	Module string
+	Err    error
+}
+
+func (e *ModuleError) Error() string {
+	return "gomodular: module " + strconv.Quote(e.Module) + " failed: " + e.Err.Error()
+}
+
+func (e *ModuleError) Unwrap() error {
+	return e.Err
+}
+
+type MultiError struct {
+	Errors []error
+}
+
+func newMultiError(errs []error) error {
+	if len(errs) == 0 {
+		return nil
+	}
+	return &MultiError{Errors: errs}
+}
+
+func (e *MultiError) Error() string {
+	messages := make([]string, len(e.Errors))
+	for i, err := range e.Errors {
+		messages[i] = err.Error()
+	}
+	return strings.Join(messages, "; ")
+}
+
+func (e *MultiError) Unwrap() []error {
+	return e.Errors
+}
+
+func (e *MultiError) Is(target error) bool {
+	for _, err := range e.Errors {
+		if errors.Is(err, target) {
+			return true
+		}
+	}
+	return false
+}
+
+func (e *MultiError) As(target interface{}) bool {
+	for _, err := range e.Errors {
+		if errors.As(err, target) {
+			return true
+		}
+	}
+	return false
+}
+
+func formatPath(path []reflect.Type) string {
+	names := make([]string, len(path))
+	for i, t := range path {
+		names[i] = t.String()
+	}
+	return strings.Join(names, " -> ")
+}
@@ -0,0 +1,96 @@
+package gomodular
+
+import "reflect"
+
+type fillGuard struct {
+	types  map[reflect.Type]bool
+	filled map[fillKey]bool
+}
+
+type fillKey struct {
+	addr uintptr
+	typ  reflect.Type
+}
+
+func (c *Gomodular) FillRecursive(structure interface{}) error {
+	receiverType := reflect.TypeOf(structure)
+	if receiverType == nil || receiverType.Kind() != reflect.Ptr || receiverType.Elem().Kind() != reflect.Struct {
+		return ErrInvalidStructure
+	}
+
+	v := reflect.ValueOf(structure)
+	if v.IsNil() {
+		return ErrInvalidStructure
+	}
+
+	g := &fillGuard{types: make(map[reflect.Type]bool), filled: make(map[fillKey]bool)}
+	g.types[receiverType.Elem()] = true
+	g.filled[fillKey{addr: v.Pointer(), typ: receiverType.Elem()}] = true
+
+	return c.fillStruct(nil, v.Elem(), "", g)
+}
+
+func (c *Gomodular) fillNested(r *resolution, v reflect.Value, path string, g *fillGuard) error {
+	switch v.Kind() {
+	case reflect.Struct:
+		if !needsFill(v.Type(), nil) {
+			return nil
+		}
+		return c.fillStruct(r, v, path, g)
+
+	case reflect.Ptr:
+		elem := v.Type().Elem()
+		if elem.Kind() != reflect.Struct || !needsFill(elem, nil) {
+			return nil
+		}
+
+		if v.IsNil() {
+			if g.types[elem] {
+				return nil
+			}
+			v.Set(reflect.New(elem))
+		}
+
+		key := fillKey{addr: v.Pointer(), typ: elem}
+		if g.filled[key] {
+			return nil
+		}
+		g.filled[key] = true
+
+		if !g.types[elem] {
+			g.types[elem] = true
+			defer delete(g.types, elem)
+		}
+
+		return c.fillStruct(r, v.Elem(), path, g)
+	}
+
+	return nil
+}
+
+func needsFill(t reflect.Type, seen map[reflect.Type]bool) bool {
+	if seen[t] {
+		return false
+	}
+	if seen == nil {
+		seen = make(map[reflect.Type]bool)
+	}
+	seen[t] = true
+
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if _, exist := field.Tag.Lookup("gomodular"); exist {
+			return true
+		}
+
+		ft := field.Type
+		if ft.Kind() == reflect.Ptr {
+			ft = ft.Elem()
+		}
+		if ft.Kind() == reflect.Struct && needsFill(ft, seen) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,160 @@
+package gomodular_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/krishpranav/gomodular"
+	"github.com/stretchr/testify/assert"
+)
+
+type HTTPDeps struct {
+	S Shape `gomodular:"type"`
+}
+
+type JobDeps struct {
+	D Database `gomodular:"type"`
+}
+
+type BaseDeps struct {
+	Rounded Shape `gomodular:"name=rounded"`
+}
+
+type Node struct {
+	S    Shape `gomodular:"type"`
+	Next *Node
+}
+
+func newRecursiveContainer(t *testing.T) *gomodular.Gomodular {
+	c := gomodular.New()
+
+	err := c.Singleton(func() Shape {
+		return &Circle{a: 13}
+	})
+	assert.NoError(t, err)
+
+	err = c.NamedSingleton("rounded", func() Shape {
+		return &Circle{a: 666}
+	})
+	assert.NoError(t, err)
+
+	err = c.Singleton(func() Database {
+		return &MySQL{}
+	})
+	assert.NoError(t, err)
+
+	return c
+}
+
+func TestGomodular_FillRecursive_With_Nested_Structs(t *testing.T) {
+	c := newRecursiveContainer(t)
+
+	server := struct {
+		BaseDeps
+		HTTP HTTPDeps
+		Jobs *JobDeps
+		jobs *JobDeps
+		Name string
+		Skip *Circle
+	}{}
+
+	err := c.FillRecursive(&server)
+	assert.NoError(t, err)
+
+	assert.Equal(t, 666, server.Rounded.GetArea())
+	assert.Equal(t, 13, server.HTTP.S.GetArea())
+	if assert.NotNil(t, server.Jobs) {
+		assert.IsType(t, &MySQL{}, server.Jobs.D)
+	}
+	if assert.NotNil(t, server.jobs) {
+		assert.IsType(t, &MySQL{}, server.jobs.D)
+	}
+	assert.Nil(t, server.Skip)
+}
+
+func TestGomodular_FillRecursive_Keeps_Existing_Pointers(t *testing.T) {
+	c := newRecursiveContainer(t)
+
+	jobs := &JobDeps{}
+	server := struct {
+		Jobs  *JobDeps
+		Again *JobDeps
+	}{Jobs: jobs, Again: jobs}
+
+	err := c.FillRecursive(&server)
+	assert.NoError(t, err)
+	assert.Same(t, jobs, server.Jobs)
+	assert.IsType(t, &MySQL{}, jobs.D)
+}
+
+func TestGomodular_FillRecursive_With_Recursive_Type(t *testing.T) {
+	c := newRecursiveContainer(t)
+
+	n := Node{}
+	n.Next = &n
+
+	err := c.FillRecursive(&n)
+	assert.NoError(t, err)
+	assert.Equal(t, 13, n.S.GetArea())
+
+	m := Node{}
+	err = c.FillRecursive(&m)
+	assert.NoError(t, err)
+	assert.Nil(t, m.Next)
+}
+
+func TestGomodular_FillRecursive_Reports_Field_Path(t *testing.T) {
+	c := gomodular.New()
+
+	server := struct {
+		HTTP struct {
+			Deps *HTTPDeps
+		}
+	}{}
+
+	err := c.FillRecursive(&server)
+	assert.EqualError(t, err, "gomodular: cannot make HTTP.Deps.S field")
+	assert.ErrorIs(t, err, gomodular.ErrNotFound)
+
+	invalid := struct {
+		HTTP struct {
+			S Shape `gomodular:"foo"`
+		}
+	}{}
+
+	err = c.FillRecursive(&invalid)
+	assert.EqualError(t, err, "gomodular: HTTP.S has an invalid struct tag: unknown key \"foo\"")
+}
+
+func TestGomodular_FillRecursive_Reports_Field_Path_Of_Failed_Resolver(t *testing.T) {
+	c := gomodular.New()
+
+	err := c.SingletonLazy(func() (Shape, error) {
+		return nil, errors.New("app: cannot make shape")
+	})
+	assert.NoError(t, err)
+
+	server := struct {
+		HTTP struct {
+			Deps *HTTPDeps
+		}
+	}{}
+
+	err = c.FillRecursive(&server)
+	assert.EqualError(t, err, "gomodular: cannot make HTTP.Deps.S field")
+	assert.ErrorIs(t, err, gomodular.ErrResolverFailed)
+
+	var fieldErr *gomodular.FieldError
+	if assert.ErrorAs(t, err, &fieldErr) {
+		assert.Equal(t, "HTTP.Deps.S", fieldErr.Field)
+	}
+}
+
+func TestGomodular_FillRecursive_With_Invalid_Structure_It_Should_Fail(t *testing.T) {
+	c := gomodular.New()
+
+	var s *Node
+	assert.ErrorIs(t, c.FillRecursive(s), gomodular.ErrInvalidStructure)
+	assert.ErrorIs(t, c.FillRecursive(Node{}), gomodular.ErrInvalidStructure)
+	assert.ErrorIs(t, c.FillRecursive(nil), gomodular.ErrInvalidStructure)
+}
@@ -0,0 +1,98 @@
+package gomodular
+
+import "reflect"
+
+func typeOf[T any]() reflect.Type {
+	return reflect.TypeOf((*T)(nil)).Elem()
+}
+
+func bindAs[T any](c *Gomodular, resolver interface{}, name string, isSingleton bool, isLazy bool) error {
+	reflectedResolver := reflect.TypeOf(resolver)
+	if reflectedResolver == nil || reflectedResolver.Kind() != reflect.Func {
+		return &InvalidResolverError{Resolver: reflectedResolver, Reason: "the resolver must be a function"}
+	}
+
+	if reflectedResolver.NumOut() == 0 || reflectedResolver.Out(0) != typeOf[T]() {
+		return &InvalidResolverError{Resolver: reflectedResolver, Reason: "resolver function signature is invalid - it must return " + typeOf[T]().String()}
+	}
+
+	return c.bind(resolver, name, isSingleton, isLazy)
+}
+
+type Constructor[T any] interface {
+	func() T | func() (T, error)
+}
+
+func BindFunc[T any, R Constructor[T]](c *Gomodular, resolver R) error {
+	return c.bind(resolver, "", true, false)
+}
+
+func NamedBindFunc[T any, R Constructor[T]](c *Gomodular, name string, resolver R) error {
+	return c.bind(resolver, name, true, false)
+}
+
+func BindLazyFunc[T any, R Constructor[T]](c *Gomodular, resolver R) error {
+	return c.bind(resolver, "", true, true)
+}
+
+func NamedBindLazyFunc[T any, R Constructor[T]](c *Gomodular, name string, resolver R) error {
+	return c.bind(resolver, name, true, true)
+}
+
+func BindTransientFunc[T any, R Constructor[T]](c *Gomodular, resolver R) error {
+	return c.bind(resolver, "", false, false)
+}
+
+func NamedBindTransientFunc[T any, R Constructor[T]](c *Gomodular, name string, resolver R) error {
+	return c.bind(resolver, name, false, false)
+}
+
+func BindTransientLazyFunc[T any, R Constructor[T]](c *Gomodular, resolver R) error {
+	return c.bind(resolver, "", false, true)
+}
+
+func NamedBindTransientLazyFunc[T any, R Constructor[T]](c *Gomodular, name string, resolver R) error {
+	return c.bind(resolver, name, false, true)
+}
+
+func Bind[T any](c *Gomodular, resolver interface{}) error {
+	return bindAs[T](c, resolver, "", true, false)
+}
+
+func BindLazy[T any](c *Gomodular, resolver interface{}) error {
+	return bindAs[T](c, resolver, "", true, true)
+}
+
+func NamedBind[T any](c *Gomodular, name string, resolver interface{}) error {
+	return bindAs[T](c, resolver, name, true, false)
+}
+
+func NamedBindLazy[T any](c *Gomodular, name string, resolver interface{}) error {
+	return bindAs[T](c, resolver, name, true, true)
+}
+
+func BindTransient[T any](c *Gomodular, resolver interface{}) error {
+	return bindAs[T](c, resolver, "", false, false)
+}
+
+func BindTransientLazy[T any](c *Gomodular, resolver interface{}) error {
+	return bindAs[T](c, resolver, "", false, true)
+}
+
+func NamedBindTransient[T any](c *Gomodular, name string, resolver interface{}) error {
+	return bindAs[T](c, resolver, name, false, false)
+}
+
+func NamedBindTransientLazy[T any](c *Gomodular, name string, resolver interface{}) error {
+	return bindAs[T](c, resolver, name, false, true)
+}
+
+func ResolveAs[T any](c *Gomodular) (T, error) {
+	return NamedResolveAs[T](c, "")
+}
+
+func NamedResolveAs[T any](c *Gomodular, name string) (T, error) {
+	var abstraction T
+	err := c.NamedResolve(&abstraction, name)
+	return abstraction, err
+}
@@ -0,0 +1,164 @@
+package gomodular_test
+
+import (
+	"testing"
+
+	"github.com/krishpranav/gomodular"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBind(t *testing.T) {
+	c := gomodular.New()
+
+	err := gomodular.Bind[Shape](c, func() Shape {
+		return &Circle{a: 13}
+	})
+	assert.NoError(t, err)
+
+	s, err := gomodular.ResolveAs[Shape](c)
+	assert.NoError(t, err)
+	assert.Equal(t, 13, s.GetArea())
+}
+
+func TestBind_With_Wrong_Return_Type_It_Should_Fail(t *testing.T) {
+	c := gomodular.New()
+
+	err := gomodular.Bind[Shape](c, func() Database {
+		return &MySQL{}
+	})
+	assert.EqualError(t, err, "gomodular: resolver function signature is invalid - it must return gomodular_test.Shape")
+}
+
+func TestBind_With_NonFunction_Resolver_It_Should_Fail(t *testing.T) {
+	c := gomodular.New()
+
+	err := gomodular.Bind[Shape](c, "STRING!")
+	assert.EqualError(t, err, "gomodular: the resolver must be a function")
+}
+
+func TestBindLazy(t *testing.T) {
+	c := gomodular.New()
+
+	err := gomodular.BindLazy[Shape](c, func(db Database) Shape {
+		return &Circle{a: 13}
+	})
+	assert.NoError(t, err)
+
+	err = gomodular.Bind[Database](c, func() Database {
+		return &MySQL{}
+	})
+	assert.NoError(t, err)
+
+	s, err := gomodular.ResolveAs[Shape](c)
+	assert.NoError(t, err)
+	assert.Equal(t, 13, s.GetArea())
+}
+
+func TestBindTransient(t *testing.T) {
+	c := gomodular.New()
+
+	err := gomodular.BindTransient[Shape](c, func() Shape {
+		return &Circle{a: 13}
+	})
+	assert.NoError(t, err)
+
+	s1, err := gomodular.ResolveAs[Shape](c)
+	assert.NoError(t, err)
+	s1.SetArea(666)
+
+	s2, err := gomodular.ResolveAs[Shape](c)
+	assert.NoError(t, err)
+	assert.Equal(t, 13, s2.GetArea())
+}
+
+func TestNamedBind(t *testing.T) {
+	c := gomodular.New()
+
+	err := gomodular.NamedBind[Shape](c, "rounded", func() Shape {
+		return &Circle{a: 13}
+	})
+	assert.NoError(t, err)
+
+	err = gomodular.NamedBindTransientLazy[Shape](c, "square", func() Shape {
+		return &Circle{a: 666}
+	})
+	assert.NoError(t, err)
+
+	s, err := gomodular.NamedResolveAs[Shape](c, "rounded")
+	assert.NoError(t, err)
+	assert.Equal(t, 13, s.GetArea())
+
+	s, err = gomodular.NamedResolveAs[Shape](c, "square")
+	assert.NoError(t, err)
+	assert.Equal(t, 666, s.GetArea())
+}
+
+func TestResolveAs_With_UnBounded_Type_It_Should_Fail(t *testing.T) {
+	c := gomodular.New()
+
+	s, err := gomodular.ResolveAs[Shape](c)
+	assert.EqualError(t, err, "gomodular: no concrete found for: gomodular_test.Shape")
+	assert.Nil(t, s)
+}
+
+func TestBindFunc(t *testing.T) {
+	c := gomodular.New()
+
+	err := gomodular.BindFunc[Shape](c, func() Shape {
+		return &Circle{a: 13}
+	})
+	assert.NoError(t, err)
+
+	err = gomodular.NamedBindFunc[Database](c, "sql", func() (Database, error) {
+		return &MySQL{}, nil
+	})
+	assert.NoError(t, err)
+
+	s, err := gomodular.ResolveAs[Shape](c)
+	assert.NoError(t, err)
+	assert.Equal(t, 13, s.GetArea())
+
+	db, err := gomodular.NamedResolveAs[Database](c, "sql")
+	assert.NoError(t, err)
+	assert.True(t, db.Connect())
+}
+
+func TestBindFunc_Lifetimes(t *testing.T) {
+	c := gomodular.New()
+
+	calls := 0
+	err := gomodular.BindLazyFunc[Shape](c, func() Shape {
+		calls++
+		return &Circle{a: 13}
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, 0, calls)
+
+	err = gomodular.NamedBindLazyFunc[Shape](c, "lazy", func() (Shape, error) {
+		return &Circle{a: 1}, nil
+	})
+	assert.NoError(t, err)
+
+	err = gomodular.BindTransientFunc[Database](c, func() Database {
+		return &MySQL{}
+	})
+	assert.NoError(t, err)
+
+	err = gomodular.NamedBindTransientLazyFunc[Database](c, "lazy", func() (Database, error) {
+		return &MySQL{}, nil
+	})
+	assert.NoError(t, err)
+
+	s1 := gomodular.MustResolveAs[Shape](c)
+	s2 := gomodular.MustResolveAs[Shape](c)
+	assert.Same(t, s1, s2)
+	assert.Equal(t, 1, calls)
+
+	for _, b := range c.Bindings() {
+		if b.Type == databaseType {
+			assert.Equal(t, "transient", b.Lifetime)
+		} else {
+			assert.Equal(t, "singleton", b.Lifetime)
+		}
+	}
+}
@@ -1,5 +1,10 @@
 package gomodular
 
+import (
+	"context"
+	"reflect"
+)
+
 var Global = New()
 
 func Singleton(resolver interface{}) error {
@@ -34,14 +39,90 @@ func NamedTransientLazy(name string, resolver interface{}) error {
 	return Global.NamedTransientLazy(name, resolver)
 }
 
+func Scoped(resolver interface{}) error {
+	return Global.Scoped(resolver)
+}
+
+func NamedScoped(name string, resolver interface{}) error {
+	return Global.NamedScoped(name, resolver)
+}
+
+func Register(resolver interface{}) error {
+	return Global.Register(resolver)
+}
+
+func NamedRegister(name string, resolver interface{}) error {
+	return Global.NamedRegister(name, resolver)
+}
+
+func Contribute(resolver interface{}) error {
+	return Global.Contribute(resolver)
+}
+
+func Install(modules ...*Module) error {
+	return Global.Install(modules...)
+}
+
+func Replace(resolver interface{}) error {
+	return Global.Replace(resolver)
+}
+
+func NamedReplace(name string, resolver interface{}) error {
+	return Global.NamedReplace(name, resolver)
+}
+
+func Decorate(decorator interface{}) error {
+	return Global.Decorate(decorator)
+}
+
+func NamedDecorate(name string, decorator interface{}) error {
+	return Global.NamedDecorate(name, decorator)
+}
+
+func Scope() *Gomodular {
+	return Global.Scope()
+}
+
+func Has(abstraction reflect.Type, name string) bool {
+	return Global.Has(abstraction, name)
+}
+
+func Bindings() []BindingInfo {
+	return Global.Bindings()
+}
+
+func Unbind(abstraction reflect.Type, name string) bool {
+	return Global.Unbind(abstraction, name)
+}
+
+func OnReplace(hook func(previous, replacement BindingInfo)) {
+	Global.OnReplace(hook)
+}
+
 func Reset() {
 	Global.Reset()
 }
 
+func Start(ctx context.Context) error {
+	return Global.Start(ctx)
+}
+
+func Stop(ctx context.Context) error {
+	return Global.Stop(ctx)
+}
+
+func Close(ctx context.Context) error {
+	return Global.Close(ctx)
+}
+
 func Call(receiver interface{}) error {
 	return Global.Call(receiver)
 }
 
+func CallContext(ctx context.Context, receiver interface{}) error {
+	return Global.CallContext(ctx, receiver)
+}
+
 func Resolve(abstraction interface{}) error {
 	return Global.Resolve(abstraction)
 }
@@ -50,6 +131,34 @@ func NamedResolve(abstraction interface{}, name string) error {
 	return Global.NamedResolve(abstraction, name)
 }
 
+func ResolveContext(ctx context.Context, abstraction interface{}) error {
+	return Global.ResolveContext(ctx, abstraction)
+}
+
+func NamedResolveContext(ctx context.Context, abstraction interface{}, name string) error {
+	return Global.NamedResolveContext(ctx, abstraction, name)
+}
+
 func Fill(receiver interface{}) error {
 	return Global.Fill(receiver)
 }
+
+func FillContext(ctx context.Context, receiver interface{}) error {
+	return Global.FillContext(ctx, receiver)
+}
+
+func FillRecursive(receiver interface{}) error {
+	return Global.FillRecursive(receiver)
+}
+
+func Build() error {
+	return Global.Build()
+}
+
+func BuildParallel(ctx context.Context, maxWorkers int) error {
+	return Global.BuildParallel(ctx, maxWorkers)
+}
+
+func Validate() error {
+	return Global.Validate()
+}
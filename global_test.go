@@ -1,6 +1,8 @@
 package gomodular_test
 
 import (
+	"context"
+	"reflect"
 	"testing"
 
 	"github.com/krishpranav/gomodular"
@@ -79,6 +81,132 @@ func TestNamedTransientLazy(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+func TestScoped(t *testing.T) {
+	gomodular.Reset()
+
+	err := gomodular.Scoped(func() Shape {
+		return &Circle{a: 13}
+	})
+	assert.NoError(t, err)
+}
+
+func TestNamedScoped(t *testing.T) {
+	gomodular.Reset()
+
+	err := gomodular.NamedScoped("rounded", func() Shape {
+		return &Circle{a: 13}
+	})
+	assert.NoError(t, err)
+}
+
+func TestRegister(t *testing.T) {
+	gomodular.Reset()
+
+	err := gomodular.Register(func() Shape {
+		return &Circle{a: 13}
+	})
+	assert.NoError(t, err)
+}
+
+func TestNamedRegister(t *testing.T) {
+	gomodular.Reset()
+
+	err := gomodular.NamedRegister("rounded", func() Shape {
+		return &Circle{a: 13}
+	})
+	assert.NoError(t, err)
+}
+
+func TestContribute(t *testing.T) {
+	gomodular.Reset()
+
+	err := gomodular.Contribute(func() Shape {
+		return &Circle{a: 13}
+	})
+	assert.NoError(t, err)
+}
+
+func TestInstall(t *testing.T) {
+	gomodular.Reset()
+
+	err := gomodular.Install(&gomodular.Module{
+		Name: "shapes",
+		Providers: []gomodular.Provider{
+			{Resolver: func() Shape { return &Circle{a: 13} }},
+		},
+	})
+	assert.NoError(t, err)
+}
+
+func TestReplace(t *testing.T) {
+	gomodular.Reset()
+
+	err := gomodular.Singleton(func() Shape {
+		return &Circle{a: 13}
+	})
+	assert.NoError(t, err)
+
+	err = gomodular.Replace(func() Shape {
+		return &Circle{a: 7}
+	})
+	assert.NoError(t, err)
+}
+
+func TestNamedReplace(t *testing.T) {
+	gomodular.Reset()
+
+	err := gomodular.NamedSingleton("rounded", func() Shape {
+		return &Circle{a: 13}
+	})
+	assert.NoError(t, err)
+
+	err = gomodular.NamedReplace("rounded", func() Shape {
+		return &Circle{a: 7}
+	})
+	assert.NoError(t, err)
+}
+
+func TestDecorate(t *testing.T) {
+	gomodular.Reset()
+
+	err := gomodular.Singleton(func() Shape {
+		return &Circle{a: 13}
+	})
+	assert.NoError(t, err)
+
+	err = gomodular.Decorate(func(s Shape) Shape {
+		return s
+	})
+	assert.NoError(t, err)
+}
+
+func TestNamedDecorate(t *testing.T) {
+	gomodular.Reset()
+
+	err := gomodular.NamedSingleton("rounded", func() Shape {
+		return &Circle{a: 13}
+	})
+	assert.NoError(t, err)
+
+	err = gomodular.NamedDecorate("rounded", func(s Shape) Shape {
+		return s
+	})
+	assert.NoError(t, err)
+}
+
+func TestScope(t *testing.T) {
+	gomodular.Reset()
+
+	err := gomodular.Singleton(func() Shape {
+		return &Circle{a: 13}
+	})
+	assert.NoError(t, err)
+
+	var s Shape
+	err = gomodular.Scope().Resolve(&s)
+	assert.NoError(t, err)
+}
+
 func TestCall(t *testing.T) {
 	gomodular.Reset()
 
@@ -129,3 +257,151 @@ func TestFill(t *testing.T) {
 	err = gomodular.Fill(&myApp)
 	assert.NoError(t, err)
 }
+
+func TestClose(t *testing.T) {
+	gomodular.Reset()
+
+	err := gomodular.Close(context.Background())
+	assert.NoError(t, err)
+}
+
+func TestStart(t *testing.T) {
+	gomodular.Reset()
+
+	err := gomodular.Start(context.Background())
+	assert.NoError(t, err)
+}
+
+func TestStop(t *testing.T) {
+	gomodular.Reset()
+
+	err := gomodular.Stop(context.Background())
+	assert.NoError(t, err)
+}
+
+func TestFillRecursive(t *testing.T) {
+	gomodular.Reset()
+
+	err := gomodular.Singleton(func() Shape {
+		return &Circle{a: 13}
+	})
+	assert.NoError(t, err)
+
+	myApp := struct {
+		Deps struct {
+			S Shape `gomodular:"type"`
+		}
+	}{}
+
+	err = gomodular.FillRecursive(&myApp)
+	assert.NoError(t, err)
+}
+
+func TestBuild(t *testing.T) {
+	gomodular.Reset()
+
+	err := gomodular.Singleton(func() Shape {
+		return &Circle{a: 13}
+	})
+	assert.NoError(t, err)
+
+	err = gomodular.Build()
+	assert.NoError(t, err)
+}
+
+func TestBuildParallel(t *testing.T) {
+	gomodular.Reset()
+
+	err := gomodular.Singleton(func() Shape {
+		return &Circle{a: 13}
+	})
+	assert.NoError(t, err)
+
+	err = gomodular.BuildParallel(context.Background(), 2)
+	assert.NoError(t, err)
+}
+
+func TestValidate(t *testing.T) {
+	gomodular.Reset()
+
+	err := gomodular.SingletonLazy(func(db Database) Shape {
+		return &Circle{a: 13}
+	})
+	assert.NoError(t, err)
+
+	err = gomodular.Validate()
+	assert.ErrorIs(t, err, gomodular.ErrNotFound)
+}
+
+func TestCallContext(t *testing.T) {
+	gomodular.Reset()
+
+	err := gomodular.CallContext(context.Background(), func(ctx context.Context) {})
+	assert.NoError(t, err)
+}
+
+func TestResolveContext(t *testing.T) {
+	gomodular.Reset()
+
+	var s Shape
+
+	err := gomodular.Singleton(func() Shape {
+		return &Circle{a: 13}
+	})
+	assert.NoError(t, err)
+
+	err = gomodular.ResolveContext(context.Background(), &s)
+	assert.NoError(t, err)
+
+	err = gomodular.NamedResolveContext(context.Background(), &s, "rounded")
+	assert.ErrorIs(t, err, gomodular.ErrNotFound)
+}
+
+func TestFillContext(t *testing.T) {
+	gomodular.Reset()
+
+	err := gomodular.Singleton(func() Shape {
+		return &Circle{a: 13}
+	})
+	assert.NoError(t, err)
+
+	myApp := struct {
+		S Shape `gomodular:"type"`
+	}{}
+
+	err = gomodular.FillContext(context.Background(), &myApp)
+	assert.NoError(t, err)
+}
+
+func TestHas(t *testing.T) {
+	gomodular.Reset()
+
+	err := gomodular.Singleton(func() Shape {
+		return &Circle{a: 13}
+	})
+	assert.NoError(t, err)
+
+	assert.True(t, gomodular.Has(reflect.TypeOf((*Shape)(nil)).Elem(), ""))
+}
+
+func TestBindings(t *testing.T) {
+	gomodular.Reset()
+
+	err := gomodular.Singleton(func() Shape {
+		return &Circle{a: 13}
+	})
+	assert.NoError(t, err)
+
+	assert.Len(t, gomodular.Bindings(), 1)
+}
+
+func TestUnbind(t *testing.T) {
+	gomodular.Reset()
+
+	err := gomodular.Singleton(func() Shape {
+		return &Circle{a: 13}
+	})
+	assert.NoError(t, err)
+
+	assert.True(t, gomodular.Unbind(reflect.TypeOf((*Shape)(nil)).Elem(), ""))
+}
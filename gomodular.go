@@ -1,178 +1,533 @@
 package gomodular
 
 import (
-	"errors"
-	"fmt"
+	"context"
 	"reflect"
+	"runtime/debug"
+	"strconv"
+	"sync"
 	"unsafe"
 )
 
+var errorType = reflect.TypeOf((*error)(nil)).Elem()
+
 type binding struct {
-	resolver    interface{}
-	concrete    interface{}
-	isSingleton bool
+	build        sync.Mutex
+	mu           sync.Mutex
+	resolver     interface{}
+	concrete     interface{}
+	isSingleton  bool
+	isScoped     bool
+	isLazy       bool
+	contributed  bool
+	instantiated bool
+	seq          uint64
+	source       string
+	decorators   []decorator
+	module       *Gomodular
+	deferred     bool
+	builder      uint64
+}
+
+func (b *binding) home(c *Gomodular) *Gomodular {
+	if b.module != nil {
+		return b.module
+	}
+	return c
 }
 
-func (b *binding) make(c Gomodular) (interface{}, error) {
-	if b.concrete != nil {
-		return b.concrete, nil
+func (b *binding) make(c *Gomodular, r *resolution) (interface{}, error) {
+	if b.isSingleton {
+		b.mu.Lock()
+		concrete, instantiated, building := b.concrete, b.instantiated, b.builder
+		b.mu.Unlock()
+		if instantiated {
+			return concrete, nil
+		}
+
+		builder := goroutineID()
+		if building == builder {
+			return nil, &CycleError{Path: append(append([]reflect.Type{}, r.path...), r.path[len(r.path)-1])}
+		}
+
+		b.build.Lock()
+		defer b.build.Unlock()
+
+		b.mu.Lock()
+		b.builder = builder
+		b.mu.Unlock()
+		defer func() {
+			b.mu.Lock()
+			b.builder = 0
+			b.mu.Unlock()
+		}()
+	}
+
+	b.mu.Lock()
+	concrete, decorators, instantiated := b.concrete, b.decorators, b.instantiated
+	b.mu.Unlock()
+
+	if b.isSingleton && instantiated {
+		return concrete, nil
 	}
 
-	retVal, err := c.invoke(b.resolver)
+	var chain []interface{}
+	retVal, err := b.home(c).invoke(r, b.resolver)
+	if err == nil {
+		chain, err = c.decorate(r, decorators, retVal)
+	}
+	c.observe(EventBuild, r.path[len(r.path)-1], r.name, b, err)
+	if err != nil {
+		return nil, err
+	}
+	retVal = chain[len(chain)-1]
+
+	b.mu.Lock()
 	if b.isSingleton {
 		b.concrete = retVal
 	}
+	b.instantiated = true
+	b.mu.Unlock()
+
+	if b.isSingleton {
+		c.track(chain...)
+	}
 
-	return retVal, err
+	return retVal, nil
 }
 
-type Gomodular map[reflect.Type]map[string]*binding
+type Gomodular struct {
+	mu            sync.RWMutex
+	bindings      map[reflect.Type]map[string]*binding
+	parent        *Gomodular
+	scoped        map[*binding]*binding
+	seq           uint64
+	closers       []interface{}
+	lifecycle     *lifecycle
+	onReplace     func(previous, replacement BindingInfo)
+	options       options
+	installed     map[*Module]bool
+	modules       []*Gomodular
+	contributions map[reflect.Type][]*binding
+	module        string
+	overwritten   []entry
+}
 
-func New() Gomodular {
-	return make(Gomodular)
+func New(opts ...Option) *Gomodular {
+	c := &Gomodular{bindings: make(map[reflect.Type]map[string]*binding), lifecycle: &lifecycle{}, options: options{lifetime: LifetimeSingleton}}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
 }
 
-func (c Gomodular) bind(resolver interface{}, name string, isSingleton bool, isLazy bool) error {
-	reflectedResolver := reflect.TypeOf(resolver)
-	if reflectedResolver.Kind() != reflect.Func {
-		return errors.New("gomodular: the resolver must be a function")
+func (c *Gomodular) Scope() *Gomodular {
+	child := New(WithParent(c))
+	child.options = c.options
+	return child
+}
+
+func (c *Gomodular) lookup(abstraction reflect.Type, name string) (*binding, *Gomodular, bool) {
+	for owner := c; owner != nil; owner = owner.parent {
+		owner.mu.RLock()
+		b, exist := owner.bindings[abstraction][name]
+		owner.mu.RUnlock()
+
+		if exist {
+			return b, owner, true
+		}
+	}
+
+	return nil, nil, false
+}
+
+func (c *Gomodular) resolve(r *resolution, abstraction reflect.Type, name string) (interface{}, bool, error) {
+	if concrete, owner, exist := c.lookup(abstraction, name); exist {
+		instance, err := c.make(r, abstraction, name, concrete, owner)
+		return instance, true, err
+	}
+
+	if name == "" {
+		return c.resolveGroup(r, abstraction)
 	}
 
-	if reflectedResolver.NumOut() > 0 {
-		if _, exist := c[reflectedResolver.Out(0)]; !exist {
-			c[reflectedResolver.Out(0)] = make(map[string]*binding)
+	return nil, false, nil
+}
+
+func instanceValue(abstraction reflect.Type, instance interface{}) reflect.Value {
+	if instance == nil {
+		return reflect.Zero(abstraction)
+	}
+	return reflect.ValueOf(instance)
+}
+
+func (c *Gomodular) make(r *resolution, abstraction reflect.Type, name string, b *binding, owner *Gomodular) (interface{}, error) {
+	r, err := r.enter(abstraction, name, b)
+	if err != nil {
+		return nil, err
+	}
+
+	if b.isScoped && owner != c {
+		return c.scopedBinding(b).make(c, r)
+	}
+	if b.isSingleton {
+		return b.make(owner, r)
+	}
+	return b.make(c, r)
+}
+
+func (c *Gomodular) scopedBinding(b *binding) *binding {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.scoped == nil {
+		c.scoped = make(map[*binding]*binding)
+	}
+	if _, exist := c.scoped[b]; !exist {
+		b.mu.Lock()
+		decorators := b.decorators
+		b.mu.Unlock()
+
+		c.scoped[b] = &binding{resolver: b.resolver, isSingleton: true, isScoped: true, isLazy: true, source: b.source, decorators: decorators, module: b.module}
+	}
+
+	return c.scoped[b]
+}
+
+func (c *Gomodular) bind(resolver interface{}, name string, isSingleton bool, isLazy bool) error {
+	return c.define(resolver, name, &binding{isSingleton: isSingleton, isLazy: isLazy}, false)
+}
+
+func (c *Gomodular) bindScoped(resolver interface{}, name string) error {
+	return c.define(resolver, name, &binding{isSingleton: true, isScoped: true, isLazy: true}, false)
+}
+
+func (c *Gomodular) define(resolver interface{}, name string, b *binding, replace bool) error {
+	reflectedResolver, err := c.resolverType(resolver)
+	if err != nil {
+		return err
+	}
+	abstraction := reflectedResolver.Out(0)
+
+	if !replace && !b.contributed {
+		if err := c.checkDuplicate(abstraction, name); err != nil {
+			return err
 		}
 	}
 
-	if err := c.validateResolverFunction(reflectedResolver); err != nil {
+	home := b.home(c)
+	if err := home.detectCycle(abstraction, name, dependencies(reflectedResolver)); err != nil {
 		return err
 	}
 
+	b.resolver = resolver
+	b.deferred = !b.isLazy && c.options.deferred
+	b.instantiated = !b.isLazy && !b.deferred
+	b.source = callerLocation()
+
 	var concrete interface{}
-	if !isLazy {
-		var err error
-		concrete, err = c.invoke(resolver)
+	if !b.isLazy && !b.deferred {
+		r := &resolution{name: name, path: []reflect.Type{abstraction}, bindings: []*binding{nil}}
+		concrete, err = home.invoke(r, resolver)
+		c.observe(EventBuild, abstraction, name, b, err)
 		if err != nil {
 			return err
 		}
 	}
 
-	if isSingleton {
-		c[reflectedResolver.Out(0)][name] = &binding{resolver: resolver, concrete: concrete, isSingleton: isSingleton}
-	} else {
-		c[reflectedResolver.Out(0)][name] = &binding{resolver: resolver, isSingleton: isSingleton}
+	if b.isSingleton {
+		b.concrete = concrete
+	}
+	if err := c.register(abstraction, name, b, replace); err != nil {
+		return err
+	}
+	if b.isSingleton {
+		c.track(concrete)
+	}
+	c.observe(EventBind, abstraction, name, b, nil)
+
+	return nil
+}
+
+func (c *Gomodular) resolverType(resolver interface{}) (reflect.Type, error) {
+	reflectedResolver := reflect.TypeOf(resolver)
+	if reflectedResolver == nil || reflectedResolver.Kind() != reflect.Func {
+		return nil, &InvalidResolverError{Resolver: reflectedResolver, Reason: "the resolver must be a function"}
+	}
+
+	if err := c.validateResolverFunction(reflectedResolver); err != nil {
+		return nil, err
+	}
+
+	return reflectedResolver, nil
+}
+
+func (c *Gomodular) checkDuplicate(abstraction reflect.Type, name string) error {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	if existing, exist := c.bindings[abstraction][name]; exist && c.options.strict {
+		return &AlreadyBoundError{Type: abstraction, Name: name, Source: existing.source}
+	}
+
+	return nil
+}
+
+func (c *Gomodular) register(abstraction reflect.Type, name string, b *binding, replace bool) error {
+	c.mu.Lock()
+	if b.contributed {
+		if c.contributions == nil {
+			c.contributions = make(map[reflect.Type][]*binding)
+		}
+		c.seq++
+		b.seq = c.seq
+		c.contributions[abstraction] = append(c.contributions[abstraction], b)
+		c.mu.Unlock()
+		return nil
+	}
+
+	previous, replaced := c.bindings[abstraction][name]
+	if replaced && c.options.strict && !replace {
+		c.mu.Unlock()
+		return &AlreadyBoundError{Type: abstraction, Name: name, Source: previous.source}
+	}
+	if _, exist := c.bindings[abstraction]; !exist {
+		c.bindings[abstraction] = make(map[string]*binding)
+	}
+	c.seq++
+	b.seq = c.seq
+	c.bindings[abstraction][name] = b
+	c.mu.Unlock()
+
+	if replaced && b.module != nil && b.module != c {
+		b.module.mu.Lock()
+		b.module.overwritten = append(b.module.overwritten, entry{abstraction: abstraction, name: name, binding: previous, owner: c})
+		b.module.mu.Unlock()
+	}
+
+	if !replaced || replace {
+		return nil
+	}
+	c.observe(EventReplace, abstraction, name, b, nil)
+	if hook := c.replaceHook(); hook != nil {
+		hook(entry{abstraction: abstraction, name: name, binding: previous, owner: c}.info(), entry{abstraction: abstraction, name: name, binding: b, owner: c}.info())
 	}
 
 	return nil
 }
 
-func (c Gomodular) validateResolverFunction(funcType reflect.Type) error {
+func (c *Gomodular) validateResolverFunction(funcType reflect.Type) error {
 	retCount := funcType.NumOut()
 
 	if retCount == 0 || retCount > 2 {
-		return errors.New("gomodular: resolver function signature is invalid - it must return abstract, or abstract and error")
+		return &InvalidResolverError{Resolver: funcType, Reason: "resolver function signature is invalid - it must return abstract, or abstract and error"}
 	}
 
 	resolveType := funcType.Out(0)
 	for i := 0; i < funcType.NumIn(); i++ {
 		if funcType.In(i) == resolveType {
-			return fmt.Errorf("gomodular: resolver function signature is invalid - depends on abstract it returns")
+			return &InvalidResolverError{Resolver: funcType, Reason: "resolver function signature is invalid - depends on abstract it returns"}
 		}
 	}
 
 	return nil
 }
 
-func (c Gomodular) invoke(function interface{}) (interface{}, error) {
-	arguments, err := c.arguments(function)
+func (c *Gomodular) invoke(r *resolution, function interface{}) (interface{}, error) {
+	arguments, err := c.arguments(r, function)
 	if err != nil {
 		return nil, err
 	}
 
-	values := reflect.ValueOf(function).Call(arguments)
+	values, err := c.call(reflect.ValueOf(function), arguments)
+	if err != nil {
+		return nil, r.failed(reflect.TypeOf(function).Out(0), err)
+	}
 	if len(values) == 2 && values[1].CanInterface() {
 		if err, ok := values[1].Interface().(error); ok {
-			return values[0].Interface(), err
+			return values[0].Interface(), r.failed(reflect.TypeOf(function).Out(0), err)
 		}
 	}
 	return values[0].Interface(), nil
 }
 
-func (c Gomodular) arguments(function interface{}) ([]reflect.Value, error) {
+func (c *Gomodular) call(function reflect.Value, arguments []reflect.Value) (values []reflect.Value, err error) {
+	if c.options.recover {
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				err = &PanicError{Value: recovered, Stack: debug.Stack()}
+			}
+		}()
+	}
+
+	return function.Call(arguments), nil
+}
+
+func (c *Gomodular) arguments(r *resolution, function interface{}) ([]reflect.Value, error) {
 	reflectedFunction := reflect.TypeOf(function)
 	argumentsCount := reflectedFunction.NumIn()
 	arguments := make([]reflect.Value, argumentsCount)
 
 	for i := 0; i < argumentsCount; i++ {
-		abstraction := reflectedFunction.In(i)
-		if concrete, exist := c[abstraction][""]; exist {
-			instance, err := concrete.make(c)
-			if err != nil {
-				return nil, err
-			}
-			arguments[i] = reflect.ValueOf(instance)
-		} else {
-			return nil, errors.New("gomodular: no concrete found for: " + abstraction.String())
+		argument, err := c.argument(r, reflectedFunction.In(i))
+		if err != nil {
+			return nil, err
 		}
+		arguments[i] = argument
 	}
 
 	return arguments, nil
 }
 
-func (c Gomodular) Reset() {
-	for k := range c {
-		delete(c, k)
+func (c *Gomodular) argument(r *resolution, abstraction reflect.Type) (reflect.Value, error) {
+	if abstraction == lifecycleType {
+		return reflect.ValueOf(c.lifecycle), nil
+	} else if abstraction == contextType {
+		return reflect.ValueOf(r.context()), nil
+	} else if isIn(abstraction) {
+		parameters := reflect.New(abstraction).Elem()
+		if err := c.fill(r, parameters); err != nil {
+			return reflect.Value{}, err
+		}
+		return parameters, nil
+	} else if isOptional(abstraction) {
+		optional := reflect.New(abstraction)
+		if err := c.provideOptional(r, optional.Interface().(optionalDependency), ""); err != nil {
+			return reflect.Value{}, err
+		}
+		return optional.Elem(), nil
+	} else if instance, exist, err := c.resolve(r, abstraction, ""); exist {
+		if err != nil {
+			return reflect.Value{}, err
+		}
+		return instanceValue(abstraction, instance), nil
 	}
+
+	return reflect.Value{}, r.notFound(abstraction, "")
 }
 
-func (c Gomodular) Singleton(resolver interface{}) error {
+func (c *Gomodular) Reset() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.bindings = make(map[reflect.Type]map[string]*binding)
+	c.contributions = nil
+	c.scoped = nil
+	c.installed = nil
+	c.modules = nil
+}
+
+func (c *Gomodular) Singleton(resolver interface{}) error {
 	return c.bind(resolver, "", true, false)
 }
 
-func (c Gomodular) SingletonLazy(resolver interface{}) error {
+func (c *Gomodular) SingletonLazy(resolver interface{}) error {
 	return c.bind(resolver, "", true, true)
 }
 
-func (c Gomodular) NamedSingleton(name string, resolver interface{}) error {
+func (c *Gomodular) NamedSingleton(name string, resolver interface{}) error {
 	return c.bind(resolver, name, true, false)
 }
 
-func (c Gomodular) NamedSingletonLazy(name string, resolver interface{}) error {
+func (c *Gomodular) NamedSingletonLazy(name string, resolver interface{}) error {
 	return c.bind(resolver, name, true, true)
 }
 
-func (c Gomodular) Transient(resolver interface{}) error {
+func (c *Gomodular) Transient(resolver interface{}) error {
 	return c.bind(resolver, "", false, false)
 }
 
-func (c Gomodular) TransientLazy(resolver interface{}) error {
+func (c *Gomodular) TransientLazy(resolver interface{}) error {
 	return c.bind(resolver, "", false, true)
 }
 
-func (c Gomodular) NamedTransient(name string, resolver interface{}) error {
+func (c *Gomodular) NamedTransient(name string, resolver interface{}) error {
 	return c.bind(resolver, name, false, false)
 }
 
-func (c Gomodular) NamedTransientLazy(name string, resolver interface{}) error {
+func (c *Gomodular) NamedTransientLazy(name string, resolver interface{}) error {
 	return c.bind(resolver, name, false, true)
 }
 
-func (c Gomodular) Call(function interface{}) error {
+func (c *Gomodular) Scoped(resolver interface{}) error {
+	return c.bindScoped(resolver, "")
+}
+
+func (c *Gomodular) NamedScoped(name string, resolver interface{}) error {
+	return c.bindScoped(resolver, name)
+}
+
+func (c *Gomodular) Register(resolver interface{}) error {
+	return c.NamedRegister("", resolver)
+}
+
+func (c *Gomodular) NamedRegister(name string, resolver interface{}) error {
+	b, err := newBinding(resolver, c.options.lifetime, false)
+	if err != nil {
+		return err
+	}
+
+	return c.define(resolver, name, b, false)
+}
+
+func newBinding(resolver interface{}, lifetime string, isLazy bool) (*binding, error) {
+	switch lifetime {
+	case LifetimeSingleton:
+		return &binding{isSingleton: true, isLazy: isLazy}, nil
+	case LifetimeTransient:
+		return &binding{isLazy: isLazy}, nil
+	case LifetimeScoped:
+		return &binding{isSingleton: true, isScoped: true, isLazy: true}, nil
+	}
+
+	return nil, &InvalidResolverError{Resolver: reflect.TypeOf(resolver), Reason: "unknown lifetime " + strconv.Quote(lifetime)}
+}
+
+func (c *Gomodular) Replace(resolver interface{}) error {
+	return c.NamedReplace("", resolver)
+}
+
+func (c *Gomodular) NamedReplace(name string, resolver interface{}) error {
+	reflectedResolver, err := c.resolverType(resolver)
+	if err != nil {
+		return err
+	}
+	abstraction := reflectedResolver.Out(0)
+
+	c.mu.RLock()
+	existing, exist := c.bindings[abstraction][name]
+	c.mu.RUnlock()
+
+	if !exist {
+		return &NotFoundError{Type: abstraction, Name: name, Path: []reflect.Type{abstraction}}
+	}
+
+	return c.define(resolver, name, &binding{isSingleton: existing.isSingleton, isScoped: existing.isScoped, isLazy: existing.isLazy, module: existing.module}, true)
+}
+
+func (c *Gomodular) Call(function interface{}) error {
+	return c.CallContext(context.Background(), function)
+}
+
+func (c *Gomodular) CallContext(ctx context.Context, function interface{}) error {
 	receiverType := reflect.TypeOf(function)
 	if receiverType == nil || receiverType.Kind() != reflect.Func {
-		return errors.New("gomodular: invalid function")
+		return ErrInvalidFunction
 	}
 
-	arguments, err := c.arguments(function)
+	arguments, err := c.arguments(&resolution{ctx: ctx}, function)
 	if err != nil {
 		return err
 	}
+	if err := ctx.Err(); err != nil {
+		return err
+	}
 
 	result := reflect.ValueOf(function).Call(arguments)
 
 	if len(result) == 0 {
 		return nil
-	} else if len(result) == 1 && result[0].CanInterface() {
+	} else if len(result) == 1 && result[0].Type() == errorType {
 		if result[0].IsNil() {
 			return nil
 		}
@@ -181,81 +536,135 @@ func (c Gomodular) Call(function interface{}) error {
 		}
 	}
 
-	return errors.New("gomodular: receiver function signature is invalid")
+	return ErrInvalidReceiver
 }
 
-func (c Gomodular) Resolve(abstraction interface{}) error {
+func (c *Gomodular) Resolve(abstraction interface{}) error {
 	return c.NamedResolve(abstraction, "")
 }
 
-func (c Gomodular) NamedResolve(abstraction interface{}, name string) error {
+func (c *Gomodular) NamedResolve(abstraction interface{}, name string) error {
+	return c.NamedResolveContext(context.Background(), abstraction, name)
+}
+
+func (c *Gomodular) ResolveContext(ctx context.Context, abstraction interface{}) error {
+	return c.NamedResolveContext(ctx, abstraction, "")
+}
+
+func (c *Gomodular) NamedResolveContext(ctx context.Context, abstraction interface{}, name string) error {
+	r := &resolution{ctx: ctx}
+
 	receiverType := reflect.TypeOf(abstraction)
 	if receiverType == nil {
-		return errors.New("gomodular: invalid abstraction")
+		return ErrInvalidAbstraction
 	}
 
 	if receiverType.Kind() == reflect.Ptr {
 		elem := receiverType.Elem()
 
-		if concrete, exist := c[elem][name]; exist {
-			if instance, err := concrete.make(c); err == nil {
-				reflect.ValueOf(abstraction).Elem().Set(reflect.ValueOf(instance))
-				return nil
-			} else {
+		if instance, exist, err := c.resolve(r, elem, name); exist {
+			if err != nil {
 				return err
 			}
+			reflect.ValueOf(abstraction).Elem().Set(instanceValue(elem, instance))
+			return nil
 		}
 
-		return errors.New("gomodular: no concrete found for: " + elem.String())
+		return r.notFound(elem, name)
 	}
 
-	return errors.New("gomodular: invalid abstraction")
+	return ErrInvalidAbstraction
+}
+
+func (c *Gomodular) Fill(structure interface{}) error {
+	return c.FillContext(context.Background(), structure)
 }
 
-func (c Gomodular) Fill(structure interface{}) error {
+func (c *Gomodular) FillContext(ctx context.Context, structure interface{}) error {
 	receiverType := reflect.TypeOf(structure)
 	if receiverType == nil {
-		return errors.New("gomodular: invalid structure")
+		return ErrInvalidStructure
 	}
 
 	if receiverType.Kind() == reflect.Ptr {
 		elem := receiverType.Elem()
 		if elem.Kind() == reflect.Struct {
-			s := reflect.ValueOf(structure).Elem()
+			return c.fill(&resolution{ctx: ctx}, reflect.ValueOf(structure).Elem())
+		}
+	}
 
-			for i := 0; i < s.NumField(); i++ {
-				f := s.Field(i)
+	return ErrInvalidStructure
+}
 
-				if t, exist := s.Type().Field(i).Tag.Lookup("gomodular"); exist {
-					var name string
+func (c *Gomodular) fill(r *resolution, s reflect.Value) error {
+	return c.fillStruct(r, s, "", nil)
+}
 
-					if t == "type" {
-						name = ""
-					} else if t == "name" {
-						name = s.Type().Field(i).Name
-					} else {
-						return fmt.Errorf("gomodular: %v has an invalid struct tag", s.Type().Field(i).Name)
-					}
+func (c *Gomodular) fillStruct(r *resolution, s reflect.Value, path string, g *fillGuard) error {
+	for i := 0; i < s.NumField(); i++ {
+		f := s.Field(i)
+		field := s.Type().Field(i)
+		ptr := reflect.NewAt(f.Type(), unsafe.Pointer(f.UnsafeAddr())).Elem()
 
-					if concrete, exist := c[f.Type()][name]; exist {
-						instance, err := concrete.make(c)
-						if err != nil {
-							return err
-						}
+		t, exist, err := parseTag(field)
+		if err != nil {
+			if tagErr, ok := err.(*InvalidTagError); ok {
+				tagErr.Field = path + tagErr.Field
+			}
+			return err
+		}
+		if !exist {
+			if g != nil {
+				if err := c.fillNested(r, ptr, path+field.Name+".", g); err != nil {
+					return err
+				}
+			}
+			continue
+		}
 
-						ptr := reflect.NewAt(f.Type(), unsafe.Pointer(f.UnsafeAddr())).Elem()
-						ptr.Set(reflect.ValueOf(instance))
+		if t.lazy {
+			ptr.Set(c.lazyField(f.Type(), t.name))
+			continue
+		}
 
-						continue
-					}
+		if isOptional(f.Type()) {
+			if err := c.provideOptional(r, ptr.Addr().Interface().(optionalDependency), t.name); err != nil {
+				return &FieldError{Field: path + field.Name, Err: err}
+			}
+			continue
+		}
 
-					return fmt.Errorf("gomodular: cannot make %v field", s.Type().Field(i).Name)
-				}
+		if instance, exist, err := c.resolve(r, f.Type(), t.name); exist {
+			if err != nil {
+				return &FieldError{Field: path + field.Name, Err: err}
 			}
 
-			return nil
+			ptr.Set(instanceValue(f.Type(), instance))
+
+			continue
+		}
+
+		if t.optional {
+			continue
 		}
+
+		return &FieldError{Field: path + field.Name, Err: r.notFound(f.Type(), t.name)}
 	}
 
-	return errors.New("gomodular: invalid structure")
+	return nil
+}
+
+func (c *Gomodular) lazyField(fieldType reflect.Type, name string) reflect.Value {
+	abstraction := fieldType.Out(0)
+
+	return reflect.MakeFunc(fieldType, func([]reflect.Value) []reflect.Value {
+		instance := reflect.New(abstraction)
+		err := c.NamedResolve(instance.Interface(), name)
+
+		errValue := reflect.Zero(errorType)
+		if err != nil {
+			errValue = reflect.ValueOf(&err).Elem()
+		}
+		return []reflect.Value{instance.Elem(), errValue}
+	})
 }
@@ -2,6 +2,9 @@ package gomodular_test
 
 import (
 	"errors"
+	"reflect"
+	"sync"
+	"sync/atomic"
 	"testing"
 
 	"github.com/krishpranav/gomodular"
@@ -362,7 +365,9 @@ func TestGomodular_Call_With_Dependency_Missing_In_Chain(t *testing.T) {
 			t.Error("Expected MySQL")
 		}
 	})
-	assert.EqualError(t, err, "gomodular: no concrete found for: gomodular_test.Shape")
+	assert.Regexp(t, `^gomodular: resolver for gomodular_test\.Database \(registered at .*gomodular_test\.go:\d+\) failed: gomodular: no concrete found for: gomodular_test\.Shape$`, err.Error())
+	assert.ErrorIs(t, err, gomodular.ErrResolverFailed)
+	assert.ErrorIs(t, err, gomodular.ErrNotFound)
 }
 
 func TestGomodular_Call_With_Unsupported_Receiver_It_Should_Fail(t *testing.T) {
@@ -550,7 +555,7 @@ func TestGomodular_Fill_With_Invalid_Tag_It_Should_Fail(t *testing.T) {
 	myApp := App{}
 
 	err := instance.Fill(&myApp)
-	assert.EqualError(t, err, "gomodular: S has an invalid struct tag")
+	assert.EqualError(t, err, "gomodular: S has an invalid struct tag: unknown key \"invalid\"")
 }
 
 func TestGomodular_Fill_With_Invalid_Field_Name_It_Should_Fail(t *testing.T) {
@@ -605,5 +610,446 @@ func TestGomodular_Fill_With_Dependency_Missing_In_Chain(t *testing.T) {
 	}{}
 
 	err = instance.Fill(&myApp)
-	assert.EqualError(t, err, "gomodular: no concrete found for: gomodular_test.Shape")
+	assert.EqualError(t, err, "gomodular: cannot make C field")
+	assert.ErrorIs(t, err, gomodular.ErrResolverFailed)
+	assert.ErrorIs(t, err, gomodular.ErrNotFound)
+
+	var fieldErr *gomodular.FieldError
+	if assert.ErrorAs(t, err, &fieldErr) {
+		assert.Regexp(t, `^gomodular: resolver for gomodular_test\.Shape \(registered at .*gomodular_test\.go:\d+\) failed: gomodular: no concrete found for: gomodular_test\.Shape named "foo"$`, fieldErr.Err.Error())
+	}
+
+	var notFoundErr *gomodular.NotFoundError
+	if assert.ErrorAs(t, err, &notFoundErr) {
+		assert.Equal(t, "foo", notFoundErr.Name)
+	}
+}
+
+func TestGomodular_SingletonLazy_Concurrent_Resolve_It_Should_Build_Once(t *testing.T) {
+	var instance = gomodular.New()
+
+	var calls int32
+	err := instance.SingletonLazy(func() Shape {
+		atomic.AddInt32(&calls, 1)
+		return &Circle{a: 13}
+	})
+	assert.NoError(t, err)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 50; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			var s Shape
+			assert.NoError(t, instance.Resolve(&s))
+			assert.Equal(t, 13, s.GetArea())
+		}()
+	}
+	wg.Wait()
+
+	assert.Equal(t, int32(1), atomic.LoadInt32(&calls))
+}
+
+func TestGomodular_Concurrent_Bind_And_Resolve(t *testing.T) {
+	var instance = gomodular.New()
+
+	err := instance.Singleton(func() Shape {
+		return &Circle{a: 13}
+	})
+	assert.NoError(t, err)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 50; i++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			assert.NoError(t, instance.Transient(func() Database {
+				return &MySQL{}
+			}))
+		}()
+		go func() {
+			defer wg.Done()
+
+			var s Shape
+			assert.NoError(t, instance.Resolve(&s))
+		}()
+	}
+	wg.Wait()
+}
+
+func TestGomodular_Scope_Resolves_From_Parent(t *testing.T) {
+	var instance = gomodular.New()
+
+	err := instance.Singleton(func() Shape {
+		return &Circle{a: 13}
+	})
+	assert.NoError(t, err)
+
+	scope := instance.Scope()
+
+	var s Shape
+	err = scope.Resolve(&s)
+	assert.NoError(t, err)
+	assert.Equal(t, 13, s.GetArea())
+
+	err = scope.Call(func(s Shape) {
+		assert.Equal(t, 13, s.GetArea())
+	})
+	assert.NoError(t, err)
+
+	myApp := struct {
+		S Shape `gomodular:"type"`
+	}{}
+	err = scope.Fill(&myApp)
+	assert.NoError(t, err)
+	assert.Equal(t, 13, myApp.S.GetArea())
+}
+
+func TestGomodular_Scope_Overrides_Parent(t *testing.T) {
+	var instance = gomodular.New()
+
+	err := instance.Singleton(func() Shape {
+		return &Circle{a: 13}
+	})
+	assert.NoError(t, err)
+
+	scope := instance.Scope()
+	err = scope.Singleton(func() Shape {
+		return &Circle{a: 666}
+	})
+	assert.NoError(t, err)
+
+	var s Shape
+	err = scope.Resolve(&s)
+	assert.NoError(t, err)
+	assert.Equal(t, 666, s.GetArea())
+
+	err = instance.Resolve(&s)
+	assert.NoError(t, err)
+	assert.Equal(t, 13, s.GetArea())
+}
+
+func TestGomodular_Scope_Transient_Uses_Scope_Dependencies(t *testing.T) {
+	var instance = gomodular.New()
+
+	err := instance.TransientLazy(func(s Shape) Database {
+		assert.Equal(t, 666, s.GetArea())
+		return &MySQL{}
+	})
+	assert.NoError(t, err)
+
+	scope := instance.Scope()
+	err = scope.Singleton(func() Shape {
+		return &Circle{a: 666}
+	})
+	assert.NoError(t, err)
+
+	var db Database
+	err = scope.Resolve(&db)
+	assert.NoError(t, err)
+
+	err = instance.Resolve(&db)
+	assert.Regexp(t, `^gomodular: no concrete found for: gomodular_test\.Shape \(required by resolver registered at .*gomodular_test\.go:\d+\)$`, err.Error())
+}
+
+func TestGomodular_Scoped(t *testing.T) {
+	var instance = gomodular.New()
+
+	err := instance.Scoped(func() Shape {
+		return &Circle{a: 13}
+	})
+	assert.NoError(t, err)
+
+	scope1 := instance.Scope()
+	scope2 := instance.Scope()
+
+	var s1, s2, s3 Shape
+	assert.NoError(t, scope1.Resolve(&s1))
+	assert.NoError(t, scope1.Resolve(&s2))
+	assert.NoError(t, scope2.Resolve(&s3))
+
+	s1.SetArea(666)
+	assert.Equal(t, 666, s2.GetArea())
+	assert.Equal(t, 13, s3.GetArea())
+}
+
+func TestGomodular_NamedScoped(t *testing.T) {
+	var instance = gomodular.New()
+
+	err := instance.NamedScoped("rounded", func() Shape {
+		return &Circle{a: 13}
+	})
+	assert.NoError(t, err)
+
+	var s Shape
+	err = instance.Scope().NamedResolve(&s, "rounded")
+	assert.NoError(t, err)
+	assert.Equal(t, 13, s.GetArea())
+}
+
+func TestGomodular_Scoped_With_Invalid_Resolver_It_Should_Fail(t *testing.T) {
+	err := instance.Scoped("STRING!")
+	assert.EqualError(t, err, "gomodular: the resolver must be a function")
+
+	err = instance.Scoped(func() {})
+	assert.Error(t, err, "gomodular: resolver function signature is invalid")
+}
+
+func TestGomodular_SingletonLazy_With_Circular_Dependency_It_Should_Fail(t *testing.T) {
+	var instance = gomodular.New()
+
+	err := instance.SingletonLazy(func(db Database) Shape {
+		return &Circle{a: 13}
+	})
+	assert.NoError(t, err)
+
+	err = instance.SingletonLazy(func(s Shape) Database {
+		return &MySQL{}
+	})
+	assert.EqualError(t, err, "gomodular: circular dependency detected: gomodular_test.Database -> gomodular_test.Shape -> gomodular_test.Database")
+
+	var cycleErr *gomodular.CycleError
+	if assert.True(t, errors.As(err, &cycleErr)) {
+		assert.Len(t, cycleErr.Path, 3)
+	}
+}
+
+func TestGomodular_Resolve_With_Circular_Dependency_It_Should_Fail(t *testing.T) {
+	var instance = gomodular.New()
+	scope := instance.Scope()
+
+	err := scope.TransientLazy(func(s Shape) Database {
+		return &MySQL{}
+	})
+	assert.NoError(t, err)
+
+	err = instance.TransientLazy(func(db Database) Shape {
+		return &Circle{a: 13}
+	})
+	assert.NoError(t, err)
+
+	var s Shape
+	err = scope.Resolve(&s)
+	assert.EqualError(t, err, "gomodular: circular dependency detected: gomodular_test.Shape -> gomodular_test.Database -> gomodular_test.Shape")
+
+	var cycleErr *gomodular.CycleError
+	assert.True(t, errors.As(err, &cycleErr))
+}
+
+func TestGomodular_Errors_Are_Typed(t *testing.T) {
+	var instance = gomodular.New()
+
+	err := instance.Singleton("STRING!")
+	assert.ErrorIs(t, err, gomodular.ErrInvalidResolver)
+
+	err = instance.Singleton(func() {})
+	var invalidResolverErr *gomodular.InvalidResolverError
+	assert.ErrorAs(t, err, &invalidResolverErr)
+
+	err = instance.Call("STRING!")
+	assert.ErrorIs(t, err, gomodular.ErrInvalidFunction)
+
+	err = instance.Call(func() int { return 13 })
+	assert.ErrorIs(t, err, gomodular.ErrInvalidReceiver)
+
+	err = instance.Resolve("STRING!")
+	assert.ErrorIs(t, err, gomodular.ErrInvalidAbstraction)
+
+	err = instance.Fill("STRING!")
+	assert.ErrorIs(t, err, gomodular.ErrInvalidStructure)
+
+	invalidTag := struct {
+		S Shape `gomodular:"invalid"`
+	}{}
+	err = instance.Fill(&invalidTag)
+	assert.ErrorIs(t, err, gomodular.ErrInvalidTag)
+
+	missingField := struct {
+		S Shape `gomodular:"type"`
+	}{}
+	err = instance.Fill(&missingField)
+	assert.ErrorIs(t, err, gomodular.ErrNotFound)
+	var fieldErr *gomodular.FieldError
+	if assert.ErrorAs(t, err, &fieldErr) {
+		assert.Equal(t, "S", fieldErr.Field)
+	}
+}
+
+func TestGomodular_NotFoundError_Keeps_Resolution_Path(t *testing.T) {
+	var instance = gomodular.New()
+
+	err := instance.SingletonLazy(func(s Shape) Database {
+		return &MySQL{}
+	})
+	assert.NoError(t, err)
+
+	var db Database
+	err = instance.Resolve(&db)
+	assert.Regexp(t, `^gomodular: no concrete found for: gomodular_test\.Shape \(required by resolver registered at .*gomodular_test\.go:\d+\)$`, err.Error())
+
+	var notFoundErr *gomodular.NotFoundError
+	if assert.ErrorAs(t, err, &notFoundErr) {
+		assert.Equal(t, "gomodular_test.Shape", notFoundErr.Type.String())
+		assert.Len(t, notFoundErr.Path, 2)
+	}
+}
+
+func TestGomodular_ResolverFailedError_Wraps_Resolver_Error(t *testing.T) {
+	var instance = gomodular.New()
+
+	appErr := errors.New("app: error")
+	err := instance.SingletonLazy(func() (Shape, error) {
+		return nil, appErr
+	})
+	assert.NoError(t, err)
+
+	err = instance.SingletonLazy(func(s Shape) Database {
+		return &MySQL{}
+	})
+	assert.NoError(t, err)
+
+	var db Database
+	err = instance.Resolve(&db)
+	assert.Regexp(t, `^gomodular: resolver for gomodular_test\.Database -> gomodular_test\.Shape \(registered at .*gomodular_test\.go:\d+\) failed: app: error$`, err.Error())
+	assert.ErrorIs(t, err, appErr)
+
+	var failedErr *gomodular.ResolverFailedError
+	if assert.ErrorAs(t, err, &failedErr) {
+		assert.Equal(t, "gomodular_test.Shape", failedErr.Type.String())
+		assert.Len(t, failedErr.Path, 2)
+	}
+}
+
+func TestGomodular_Strict_Bindings_Reject_Duplicates(t *testing.T) {
+	instance := gomodular.New(gomodular.WithStrictBindings())
+
+	err := instance.Singleton(func() Shape {
+		return &Circle{a: 13}
+	})
+	assert.NoError(t, err)
+
+	built := false
+	err = instance.Singleton(func() Shape {
+		built = true
+		return &Circle{a: 7}
+	})
+	assert.ErrorIs(t, err, gomodular.ErrAlreadyBound)
+	assert.False(t, built)
+
+	var alreadyBoundErr *gomodular.AlreadyBoundError
+	if assert.ErrorAs(t, err, &alreadyBoundErr) {
+		assert.Equal(t, "gomodular_test.Shape", alreadyBoundErr.Type.String())
+		assert.Regexp(t, `gomodular_test\.go:\d+$`, alreadyBoundErr.Source)
+	}
+
+	err = instance.NamedSingleton("rounded", func() Shape {
+		return &Circle{a: 7}
+	})
+	assert.NoError(t, err)
+
+	var s Shape
+	err = instance.Resolve(&s)
+	assert.NoError(t, err)
+	assert.Equal(t, 13, s.GetArea())
+
+	err = instance.Scope().Singleton(func() Shape {
+		return &Circle{a: 7}
+	})
+	assert.NoError(t, err)
+}
+
+func TestGomodular_Replace(t *testing.T) {
+	instance := gomodular.New(gomodular.WithStrictBindings())
+
+	replaced := false
+	instance.OnReplace(func(previous, replacement gomodular.BindingInfo) {
+		replaced = true
+	})
+
+	err := instance.TransientLazy(func() Shape {
+		return &Circle{a: 13}
+	})
+	assert.NoError(t, err)
+
+	err = instance.Replace(func() Shape {
+		return &Circle{a: 7}
+	})
+	assert.NoError(t, err)
+	assert.False(t, replaced)
+
+	var s Shape
+	err = instance.Resolve(&s)
+	assert.NoError(t, err)
+	assert.Equal(t, 7, s.GetArea())
+
+	bindings := instance.Bindings()
+	if assert.Len(t, bindings, 1) {
+		assert.Equal(t, "transient", bindings[0].Lifetime)
+		assert.True(t, bindings[0].Lazy)
+	}
+
+	err = instance.NamedReplace("rounded", func() Shape {
+		return &Circle{a: 7}
+	})
+	assert.ErrorIs(t, err, gomodular.ErrNotFound)
+}
+
+func TestGomodular_SingletonLazy_With_Nil_Concrete_It_Should_Build_Once(t *testing.T) {
+	var instance = gomodular.New()
+
+	calls := 0
+	err := instance.SingletonLazy(func() Shape {
+		calls++
+		return nil
+	})
+	assert.NoError(t, err)
+
+	for i := 0; i < 2; i++ {
+		var s Shape
+		assert.NoError(t, instance.Resolve(&s))
+		assert.Nil(t, s)
+	}
+
+	err = instance.Decorate(func(s Shape) Shape {
+		calls++
+		return s
+	})
+	assert.NoError(t, err)
+
+	var s Shape
+	assert.NoError(t, instance.Resolve(&s))
+	assert.Equal(t, 2, calls)
+}
+
+func TestGomodular_SingletonLazy_With_Circular_Resolve_In_Resolvers_It_Should_Fail(t *testing.T) {
+	var instance = gomodular.New()
+
+	err := instance.SingletonLazy(func() (Shape, error) {
+		var db Database
+		if err := instance.Resolve(&db); err != nil {
+			return nil, err
+		}
+		return &Circle{}, nil
+	})
+	assert.NoError(t, err)
+
+	err = instance.SingletonLazy(func() (Database, error) {
+		var s Shape
+		if err := instance.Resolve(&s); err != nil {
+			return nil, err
+		}
+		return &MySQL{}, nil
+	})
+	assert.NoError(t, err)
+
+	var s Shape
+	err = instance.Resolve(&s)
+	assert.ErrorIs(t, err, gomodular.ErrCircularDependency)
+	assert.ErrorIs(t, err, gomodular.ErrResolverFailed)
+
+	var cycleErr *gomodular.CycleError
+	if assert.ErrorAs(t, err, &cycleErr) {
+		assert.Equal(t, []reflect.Type{shapeType, shapeType}, cycleErr.Path)
+	}
 }
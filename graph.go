@@ -0,0 +1,201 @@
+package gomodular
+
+import (
+	"encoding/json"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+type Graph struct {
+	Nodes []Node `json:"nodes"`
+}
+
+type Node struct {
+	Type         string       `json:"type"`
+	Name         string       `json:"name,omitempty"`
+	Lifetime     string       `json:"lifetime"`
+	Lazy         bool         `json:"lazy"`
+	Instantiated bool         `json:"instantiated"`
+	Private      bool         `json:"private,omitempty"`
+	Module       string       `json:"module,omitempty"`
+	Dependencies []Dependency `json:"dependencies,omitempty"`
+}
+
+type Dependency struct {
+	Type     string `json:"type"`
+	Name     string `json:"name,omitempty"`
+	Optional bool   `json:"optional,omitempty"`
+	Lazy     bool   `json:"lazy,omitempty"`
+	Group    string `json:"group,omitempty"`
+	Private  bool   `json:"private,omitempty"`
+}
+
+type entry struct {
+	abstraction reflect.Type
+	name        string
+	binding     *binding
+	owner       *Gomodular
+}
+
+func (c *Gomodular) entries() []entry {
+	var chain []*Gomodular
+	for owner := c; owner != nil; owner = owner.parent {
+		chain = append([]*Gomodular{owner}, chain...)
+	}
+
+	type key struct {
+		abstraction reflect.Type
+		name        string
+	}
+
+	var entries []entry
+	index := make(map[key]int)
+	for _, owner := range chain {
+		owner.mu.RLock()
+		var own []entry
+		for abstraction, bindings := range owner.bindings {
+			for name, b := range bindings {
+				own = append(own, entry{abstraction: abstraction, name: name, binding: b, owner: owner})
+			}
+		}
+		owner.mu.RUnlock()
+
+		sort.Slice(own, func(i, j int) bool {
+			return own[i].binding.seq < own[j].binding.seq
+		})
+
+		for _, e := range own {
+			k := key{abstraction: e.abstraction, name: e.name}
+			if i, exist := index[k]; exist {
+				entries[i] = e
+				continue
+			}
+			index[k] = len(entries)
+			entries = append(entries, e)
+		}
+	}
+
+	return entries
+}
+
+func (b *binding) lifetime() string {
+	if b.isScoped {
+		return LifetimeScoped
+	} else if b.isSingleton {
+		return LifetimeSingleton
+	}
+	return LifetimeTransient
+}
+
+func (b *binding) isInstantiated() bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	return b.instantiated
+}
+
+func (c *Gomodular) Graph() *Graph {
+	g := &Graph{}
+	for _, e := range c.allEntries() {
+		node := Node{
+			Type:         e.abstraction.String(),
+			Name:         e.name,
+			Lifetime:     e.binding.lifetime(),
+			Lazy:         e.binding.isLazy,
+			Instantiated: e.binding.isInstantiated(),
+		}
+		if e.binding.module != nil {
+			node.Private = e.isPrivate()
+			node.Module = e.binding.module.module
+		}
+
+		home := e.binding.home(c)
+		for _, d := range e.binding.dependencies() {
+			dep := Dependency{Type: d.Type.String(), Name: d.Name, Optional: d.Optional, Lazy: d.Lazy}
+			if b, owner, exist := home.lookup(d.Type, d.Name); exist {
+				dep.Private = entry{binding: b, owner: owner}.isPrivate()
+			} else if d.Name == "" {
+				if elem, ok := groupElem(d.Type); ok {
+					dep.Group = elem.String()
+				}
+			}
+			node.Dependencies = append(node.Dependencies, dep)
+		}
+
+		g.Nodes = append(g.Nodes, node)
+	}
+
+	return g
+}
+
+func (e entry) isPrivate() bool {
+	return e.binding.module != nil && e.binding.module == e.owner
+}
+
+func (g *Graph) JSON() ([]byte, error) {
+	return json.MarshalIndent(g, "", "  ")
+}
+
+func (g *Graph) DOT() string {
+	var b strings.Builder
+	b.WriteString("digraph gomodular {\n")
+
+	for _, node := range g.Nodes {
+		label := node.Type
+		if node.Name != "" {
+			label += "\\n" + strconv.Quote(node.Name)
+		}
+		label += "\\n" + node.Lifetime
+		if node.Lazy {
+			label += ", lazy"
+		}
+		if node.Private {
+			label += "\\nprivate to " + strconv.Quote(node.Module)
+		}
+
+		style := "solid"
+		if !node.Instantiated {
+			style = "dashed"
+		}
+
+		b.WriteString("  " + strconv.Quote(node.id()) + " [label=\"" + strings.ReplaceAll(label, `"`, `\"`) + "\", style=" + style + "];\n")
+	}
+
+	for _, node := range g.Nodes {
+		from := strconv.Quote(node.id())
+		for _, dep := range node.Dependencies {
+			attributes := ""
+			if dep.Optional || dep.Lazy {
+				attributes = " [style=dashed]"
+			}
+
+			if dep.Group == "" {
+				to := Node{Type: dep.Type, Name: dep.Name, Private: dep.Private, Module: node.Module}
+				b.WriteString("  " + from + " -> " + strconv.Quote(to.id()) + attributes + ";\n")
+				continue
+			}
+
+			for _, member := range g.Nodes {
+				if member.Type == dep.Group && (!member.Private || member.Module == node.Module) {
+					b.WriteString("  " + from + " -> " + strconv.Quote(member.id()) + attributes + ";\n")
+				}
+			}
+		}
+	}
+
+	b.WriteString("}\n")
+	return b.String()
+}
+
+func (n Node) id() string {
+	id := n.Type
+	if n.Name != "" {
+		id += "#" + n.Name
+	}
+	if n.Private {
+		id += "@" + n.Module
+	}
+	return id
+}
@@ -0,0 +1,143 @@
+package gomodular_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/krishpranav/gomodular"
+	"github.com/stretchr/testify/assert"
+)
+
+type Mailer interface {
+	Send(to string, body string) error
+}
+
+func newGraphContainer(t *testing.T) *gomodular.Gomodular {
+	c := gomodular.New()
+
+	err := c.Singleton(func() Database {
+		return &MySQL{}
+	})
+	assert.NoError(t, err)
+
+	err = c.NamedTransientLazy("rounded", func(db Database, s gomodular.Optional[Shape]) Shape {
+		return &Circle{}
+	})
+	assert.NoError(t, err)
+
+	return c
+}
+
+func TestGomodular_Graph(t *testing.T) {
+	c := newGraphContainer(t)
+
+	g := c.Graph()
+	assert.Equal(t, []gomodular.Node{
+		{
+			Type:         "gomodular_test.Database",
+			Lifetime:     "singleton",
+			Instantiated: true,
+		},
+		{
+			Type:     "gomodular_test.Shape",
+			Name:     "rounded",
+			Lifetime: "transient",
+			Lazy:     true,
+			Dependencies: []gomodular.Dependency{
+				{Type: "gomodular_test.Database"},
+				{Type: "gomodular_test.Shape", Optional: true},
+			},
+		},
+	}, g.Nodes)
+
+	var s Shape
+	assert.NoError(t, c.NamedResolve(&s, "rounded"))
+	assert.True(t, c.Graph().Nodes[1].Instantiated)
+}
+
+func TestGomodular_Graph_Includes_Parent_Bindings(t *testing.T) {
+	c := newGraphContainer(t)
+	scope := c.Scope()
+
+	err := scope.Scoped(func() Database {
+		return &MySQL{}
+	})
+	assert.NoError(t, err)
+
+	g := scope.Graph()
+	if assert.Len(t, g.Nodes, 2) {
+		assert.Equal(t, "scoped", g.Nodes[0].Lifetime)
+		assert.Equal(t, "gomodular_test.Shape", g.Nodes[1].Type)
+	}
+}
+
+func TestGraph_DOT(t *testing.T) {
+	c := newGraphContainer(t)
+
+	err := c.SingletonLazy(func(shapes []Shape) Mailer {
+		return nil
+	})
+	assert.NoError(t, err)
+
+	assert.Equal(t, `digraph gomodular {
+  "gomodular_test.Database" [label="gomodular_test.Database\nsingleton", style=solid];
+  "gomodular_test.Shape#rounded" [label="gomodular_test.Shape\n\"rounded\"\ntransient, lazy", style=dashed];
+  "gomodular_test.Mailer" [label="gomodular_test.Mailer\nsingleton, lazy", style=dashed];
+  "gomodular_test.Shape#rounded" -> "gomodular_test.Database";
+  "gomodular_test.Shape#rounded" -> "gomodular_test.Shape" [style=dashed];
+  "gomodular_test.Mailer" -> "gomodular_test.Shape#rounded";
+}
+`, c.Graph().DOT())
+}
+
+func TestGraph_JSON(t *testing.T) {
+	c := newGraphContainer(t)
+
+	data, err := c.Graph().JSON()
+	assert.NoError(t, err)
+
+	var decoded gomodular.Graph
+	assert.NoError(t, json.Unmarshal(data, &decoded))
+	assert.Equal(t, c.Graph(), &decoded)
+	assert.Contains(t, string(data), `"lifetime": "transient"`)
+}
+
+func TestGraph_DOT_With_Private_Module_Bindings(t *testing.T) {
+	c := gomodular.New()
+
+	circle := &gomodular.Module{
+		Name: "circle",
+		Providers: []gomodular.Provider{
+			{Resolver: func() Shape { return &Circle{a: 1} }, Private: true},
+			{Resolver: func(s Shape) int { return s.GetArea() }, Name: "circle"},
+			{Resolver: func(shapes []Shape) Database { return &MySQL{} }, Lazy: true},
+		},
+	}
+	square := &gomodular.Module{
+		Name: "square",
+		Providers: []gomodular.Provider{
+			{Resolver: func() Shape { return &Circle{a: 4} }, Private: true},
+			{Resolver: func(s Shape) int { return s.GetArea() }, Name: "square"},
+		},
+	}
+
+	err := c.Install(circle, square)
+	assert.NoError(t, err)
+
+	g := c.Graph()
+	if assert.Len(t, g.Nodes, 5) {
+		assert.Equal(t, gomodular.Node{Type: "gomodular_test.Shape", Lifetime: "singleton", Instantiated: true, Private: true, Module: "square"}, g.Nodes[4])
+	}
+
+	assert.Equal(t, `digraph gomodular {
+  "int#circle" [label="int\n\"circle\"\nsingleton", style=solid];
+  "gomodular_test.Database" [label="gomodular_test.Database\nsingleton, lazy", style=dashed];
+  "int#square" [label="int\n\"square\"\nsingleton", style=solid];
+  "gomodular_test.Shape@circle" [label="gomodular_test.Shape\nsingleton\nprivate to \"circle\"", style=solid];
+  "gomodular_test.Shape@square" [label="gomodular_test.Shape\nsingleton\nprivate to \"square\"", style=solid];
+  "int#circle" -> "gomodular_test.Shape@circle";
+  "gomodular_test.Database" -> "gomodular_test.Shape@circle";
+  "int#square" -> "gomodular_test.Shape@square";
+}
+`, g.DOT())
+}
@@ -0,0 +1,122 @@
+package gomodular
+
+import (
+	"reflect"
+	"sort"
+)
+
+func groupElem(t reflect.Type) (reflect.Type, bool) {
+	switch t.Kind() {
+	case reflect.Slice:
+		return t.Elem(), true
+	case reflect.Map:
+		if t.Key().Kind() == reflect.String {
+			return t.Elem(), true
+		}
+	}
+
+	return nil, false
+}
+
+func (c *Gomodular) group(abstraction reflect.Type) []entry {
+	elem, ok := groupElem(abstraction)
+	if !ok {
+		return nil
+	}
+
+	var chain []*Gomodular
+	for owner := c; owner != nil; owner = owner.parent {
+		chain = append([]*Gomodular{owner}, chain...)
+	}
+
+	var members []entry
+	index := make(map[string]int)
+	for _, owner := range chain {
+		owner.mu.RLock()
+		var own []entry
+		for name, b := range owner.bindings[elem] {
+			own = append(own, entry{abstraction: elem, name: name, binding: b, owner: owner})
+		}
+		if abstraction.Kind() == reflect.Slice {
+			for _, b := range owner.contributions[elem] {
+				own = append(own, entry{abstraction: elem, binding: b, owner: owner})
+			}
+		}
+		owner.mu.RUnlock()
+
+		sort.Slice(own, func(i, j int) bool {
+			return own[i].binding.seq < own[j].binding.seq
+		})
+
+		for _, member := range own {
+			if member.binding.contributed {
+				members = append(members, member)
+				continue
+			}
+			if i, exist := index[member.name]; exist {
+				members[i] = member
+				continue
+			}
+			index[member.name] = len(members)
+			members = append(members, member)
+		}
+	}
+
+	return members
+}
+
+func (c *Gomodular) resolveGroup(r *resolution, abstraction reflect.Type) (interface{}, bool, error) {
+	members := c.group(abstraction)
+	if len(members) == 0 {
+		return nil, false, nil
+	}
+	elem := abstraction.Elem()
+
+	var group reflect.Value
+	if abstraction.Kind() == reflect.Slice {
+		group = reflect.MakeSlice(abstraction, 0, len(members))
+	} else {
+		group = reflect.MakeMapWithSize(abstraction, len(members))
+	}
+
+	for _, member := range members {
+		instance, err := c.make(r, elem, member.name, member.binding, member.owner)
+		if err != nil {
+			return nil, true, err
+		}
+
+		value := instanceValue(elem, instance)
+		if abstraction.Kind() == reflect.Slice {
+			group = reflect.Append(group, value)
+		} else {
+			group.SetMapIndex(reflect.ValueOf(member.name).Convert(abstraction.Key()), value)
+		}
+	}
+
+	return group.Interface(), true, nil
+}
+
+func (c *Gomodular) Contribute(resolver interface{}) error {
+	b, err := newBinding(resolver, c.options.lifetime, false)
+	if err != nil {
+		return err
+	}
+	b.contributed = true
+
+	return c.define(resolver, "", b, false)
+}
+
+func (c *Gomodular) contributed() []entry {
+	var entries []entry
+	for owner := c; owner != nil; owner = owner.parent {
+		owner.mu.RLock()
+		for abstraction, bindings := range owner.contributions {
+			for _, b := range bindings {
+				entries = append(entries, entry{abstraction: abstraction, binding: b, owner: owner})
+			}
+		}
+		owner.mu.RUnlock()
+	}
+
+	return entries
+}
@@ -0,0 +1,203 @@
+package gomodular_test
+
+import (
+	"testing"
+
+	"github.com/krishpranav/gomodular"
+	"github.com/stretchr/testify/assert"
+)
+
+func newGroupContainer(t *testing.T) *gomodular.Gomodular {
+	c := gomodular.New()
+
+	for i, name := range []string{"small", "medium", "large"} {
+		area := i + 1
+		err := c.NamedSingleton(name, func() Shape {
+			return &Circle{a: area}
+		})
+		assert.NoError(t, err)
+	}
+
+	return c
+}
+
+func areas(shapes []Shape) []int {
+	var result []int
+	for _, s := range shapes {
+		result = append(result, s.GetArea())
+	}
+	return result
+}
+
+func TestGomodular_Resolve_Group_As_Slice(t *testing.T) {
+	c := newGroupContainer(t)
+
+	var shapes []Shape
+	err := c.Resolve(&shapes)
+	assert.NoError(t, err)
+	assert.Equal(t, []int{1, 2, 3}, areas(shapes))
+}
+
+func TestGomodular_Resolve_Group_As_Map(t *testing.T) {
+	c := newGroupContainer(t)
+
+	var shapes map[string]Shape
+	err := c.Resolve(&shapes)
+	assert.NoError(t, err)
+	assert.Len(t, shapes, 3)
+	assert.Equal(t, 2, shapes["medium"].GetArea())
+}
+
+func TestGomodular_Call_With_Group(t *testing.T) {
+	c := newGroupContainer(t)
+
+	err := c.Singleton(func(shapes []Shape) Database {
+		assert.Equal(t, []int{1, 2, 3}, areas(shapes))
+		return &MySQL{}
+	})
+	assert.NoError(t, err)
+
+	err = c.Call(func(shapes []Shape, byName map[string]Shape) {
+		assert.Len(t, shapes, 3)
+		assert.Len(t, byName, 3)
+	})
+	assert.NoError(t, err)
+}
+
+func TestGomodular_Fill_With_Group(t *testing.T) {
+	c := newGroupContainer(t)
+
+	myApp := struct {
+		Shapes []Shape `gomodular:"type"`
+	}{}
+
+	err := c.Fill(&myApp)
+	assert.NoError(t, err)
+	assert.Equal(t, []int{1, 2, 3}, areas(myApp.Shapes))
+}
+
+func TestGomodular_Group_In_Scope(t *testing.T) {
+	c := newGroupContainer(t)
+	scope := c.Scope()
+
+	err := scope.NamedSingleton("medium", func() Shape {
+		return &Circle{a: 20}
+	})
+	assert.NoError(t, err)
+
+	err = scope.NamedSingleton("huge", func() Shape {
+		return &Circle{a: 40}
+	})
+	assert.NoError(t, err)
+
+	var shapes []Shape
+	err = scope.Resolve(&shapes)
+	assert.NoError(t, err)
+	assert.Equal(t, []int{1, 20, 3, 40}, areas(shapes))
+}
+
+func TestGomodular_Explicit_Slice_Binding_Wins_Over_Group(t *testing.T) {
+	c := newGroupContainer(t)
+
+	err := c.Singleton(func() []Shape {
+		return []Shape{&Circle{a: 13}}
+	})
+	assert.NoError(t, err)
+
+	var shapes []Shape
+	err = c.Resolve(&shapes)
+	assert.NoError(t, err)
+	assert.Equal(t, []int{13}, areas(shapes))
+}
+
+func TestGomodular_Resolve_Empty_Group_It_Should_Fail(t *testing.T) {
+	c := gomodular.New()
+
+	var shapes []Shape
+	err := c.Resolve(&shapes)
+	assert.ErrorIs(t, err, gomodular.ErrNotFound)
+
+	var names []string
+	err = c.Resolve(&names)
+	assert.ErrorIs(t, err, gomodular.ErrNotFound)
+
+	err = c.SingletonLazy(func(shapes []Shape) Database {
+		return &MySQL{}
+	})
+	assert.NoError(t, err)
+	assert.ErrorIs(t, c.Validate(), gomodular.ErrNotFound)
+}
+
+func TestGomodular_Contribute(t *testing.T) {
+	c := gomodular.New()
+
+	for _, area := range []int{1, 2} {
+		area := area
+		err := c.Contribute(func() Shape {
+			return &Circle{a: area}
+		})
+		assert.NoError(t, err)
+	}
+
+	err := c.NamedSingleton("large", func() Shape {
+		return &Circle{a: 3}
+	})
+	assert.NoError(t, err)
+
+	var shapes []Shape
+	err = c.Resolve(&shapes)
+	assert.NoError(t, err)
+	assert.Equal(t, []int{1, 2, 3}, areas(shapes))
+
+	var byName map[string]Shape
+	err = c.Resolve(&byName)
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]Shape{"large": byName["large"]}, byName)
+
+	assert.Len(t, c.Bindings(), 1)
+	assert.Len(t, c.Graph().Nodes, 1)
+	assert.False(t, c.Has(shapeType, ""))
+
+	err = c.Contribute(func() {})
+	assert.ErrorIs(t, err, gomodular.ErrInvalidResolver)
+}
+
+func TestGomodular_Contribute_In_Scope(t *testing.T) {
+	c := gomodular.New(gomodular.WithStrictBindings())
+
+	err := c.Singleton(func() Shape {
+		return &Circle{a: 1}
+	})
+	assert.NoError(t, err)
+
+	err = c.Contribute(func() Shape {
+		return &Circle{a: 2}
+	})
+	assert.NoError(t, err)
+
+	scope := c.Scope()
+	err = scope.Contribute(func() Shape {
+		return &Circle{a: 3}
+	})
+	assert.NoError(t, err)
+
+	var shapes []Shape
+	err = scope.Resolve(&shapes)
+	assert.NoError(t, err)
+	assert.Equal(t, []int{1, 2, 3}, areas(shapes))
+
+	var byName map[string]Shape
+	err = scope.Resolve(&byName)
+	assert.NoError(t, err)
+	assert.Len(t, byName, 1)
+	assert.Equal(t, 1, byName[""].GetArea())
+}
+
+func TestGomodular_Group_Member_Depending_On_Group_It_Should_Fail(t *testing.T) {
+	c := newGroupContainer(t)
+
+	err := c.NamedSingletonLazy("all", func(shapes []Shape) Shape {
+		return &Circle{}
+	})
+	assert.ErrorIs(t, err, gomodular.ErrCircularDependency)
+}
@@ -0,0 +1,64 @@
+package gomodular
+
+import "reflect"
+
+type In struct{}
+
+var inType = reflect.TypeOf(In{})
+
+func isIn(t reflect.Type) bool {
+	if t.Kind() != reflect.Struct {
+		return false
+	}
+
+	for i := 0; i < t.NumField(); i++ {
+		if field := t.Field(i); field.Anonymous && field.Type == inType {
+			return true
+		}
+	}
+
+	return false
+}
+
+type dependency struct {
+	Type     reflect.Type
+	Name     string
+	Optional bool
+	Lazy     bool
+}
+
+func dependencies(funcType reflect.Type) []dependency {
+	var deps []dependency
+	for i := 0; i < funcType.NumIn(); i++ {
+		t := funcType.In(i)
+		if t == lifecycleType || t == contextType {
+			continue
+		}
+
+		if isOptional(t) {
+			deps = append(deps, dependency{Type: optionalType(t), Optional: true})
+			continue
+		}
+
+		if !isIn(t) {
+			deps = append(deps, dependency{Type: t})
+			continue
+		}
+
+		for j := 0; j < t.NumField(); j++ {
+			field := t.Field(j)
+			if tag, exist, err := parseTag(field); err == nil && exist {
+				d := dependency{Type: field.Type, Name: tag.name, Optional: tag.optional, Lazy: tag.lazy}
+				if tag.lazy {
+					d.Type = field.Type.Out(0)
+				} else if isOptional(field.Type) {
+					d.Type = optionalType(field.Type)
+					d.Optional = true
+				}
+				deps = append(deps, d)
+			}
+		}
+	}
+
+	return deps
+}
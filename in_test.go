@@ -0,0 +1,93 @@
+package gomodular_test
+
+import (
+	"testing"
+
+	"github.com/krishpranav/gomodular"
+	"github.com/stretchr/testify/assert"
+)
+
+type ShapeParams struct {
+	gomodular.In
+
+	Default Shape `gomodular:"type"`
+	Rounded Shape `gomodular:"name"`
+	Ignored int
+}
+
+func TestGomodular_Call_With_In_Parameters(t *testing.T) {
+	c := gomodular.New()
+
+	err := c.Singleton(func() Shape {
+		return &Circle{a: 13}
+	})
+	assert.NoError(t, err)
+
+	err = c.NamedSingleton("Rounded", func() Shape {
+		return &Circle{a: 666}
+	})
+	assert.NoError(t, err)
+
+	err = c.Call(func(p ShapeParams) {
+		assert.Equal(t, 13, p.Default.GetArea())
+		assert.Equal(t, 666, p.Rounded.GetArea())
+		assert.Equal(t, 0, p.Ignored)
+	})
+	assert.NoError(t, err)
+}
+
+func TestGomodular_Resolver_With_In_Parameters(t *testing.T) {
+	c := gomodular.New()
+
+	err := c.NamedSingleton("Rounded", func() Shape {
+		return &Circle{a: 666}
+	})
+	assert.NoError(t, err)
+
+	err = c.Singleton(func() Shape {
+		return &Circle{a: 13}
+	})
+	assert.NoError(t, err)
+
+	err = c.SingletonLazy(func(p ShapeParams) Database {
+		assert.Equal(t, 666, p.Rounded.GetArea())
+		return &MySQL{}
+	})
+	assert.NoError(t, err)
+
+	var db Database
+	assert.NoError(t, c.Resolve(&db))
+}
+
+func TestGomodular_Call_With_Missing_In_Parameter_It_Should_Fail(t *testing.T) {
+	c := gomodular.New()
+
+	err := c.Singleton(func() Shape {
+		return &Circle{a: 13}
+	})
+	assert.NoError(t, err)
+
+	err = c.Call(func(p ShapeParams) {})
+	assert.EqualError(t, err, "gomodular: cannot make Rounded field")
+	assert.ErrorIs(t, err, gomodular.ErrNotFound)
+}
+
+func TestGomodular_NamedSingletonLazy_With_In_Parameters_Circular_Dependency_It_Should_Fail(t *testing.T) {
+	c := gomodular.New()
+
+	err := c.SingletonLazy(func(db Database) Shape {
+		return &Circle{a: 13}
+	})
+	assert.NoError(t, err)
+
+	err = c.NamedSingletonLazy("Rounded", func() Shape {
+		return &Circle{a: 666}
+	})
+	assert.NoError(t, err)
+
+	err = c.SingletonLazy(func(p ShapeParams) Database {
+		return &MySQL{}
+	})
+	assert.EqualError(t, err, "gomodular: circular dependency detected: gomodular_test.Database -> gomodular_test.Shape -> gomodular_test.Database")
+	assert.ErrorIs(t, err, gomodular.ErrCircularDependency)
+}
@@ -0,0 +1,74 @@
+package gomodular
+
+import "reflect"
+
+type BindingInfo struct {
+	Type         reflect.Type
+	Name         string
+	Lifetime     string
+	Lazy         bool
+	Instantiated bool
+	Source       string
+}
+
+func (c *Gomodular) Has(abstraction reflect.Type, name string) bool {
+	_, _, exist := c.lookup(abstraction, name)
+	return exist
+}
+
+func (c *Gomodular) Bindings() []BindingInfo {
+	var infos []BindingInfo
+	for _, e := range c.entries() {
+		infos = append(infos, e.info())
+	}
+
+	return infos
+}
+
+func (e entry) info() BindingInfo {
+	return BindingInfo{
+		Type:         e.abstraction,
+		Name:         e.name,
+		Lifetime:     e.binding.lifetime(),
+		Lazy:         e.binding.isLazy,
+		Instantiated: e.binding.isInstantiated(),
+		Source:       e.binding.source,
+	}
+}
+
+func (c *Gomodular) OnReplace(hook func(previous, replacement BindingInfo)) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.onReplace = hook
+}
+
+func (c *Gomodular) replaceHook() func(previous, replacement BindingInfo) {
+	for owner := c; owner != nil; owner = owner.parent {
+		owner.mu.RLock()
+		hook := owner.onReplace
+		owner.mu.RUnlock()
+
+		if hook != nil {
+			return hook
+		}
+	}
+
+	return nil
+}
+
+func (c *Gomodular) Unbind(abstraction reflect.Type, name string) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if _, exist := c.bindings[abstraction][name]; !exist {
+		return false
+	}
+
+	delete(c.bindings[abstraction], name)
+	if len(c.bindings[abstraction]) == 0 {
+		delete(c.bindings, abstraction)
+	}
+
+	return true
+}
@@ -0,0 +1,131 @@
+package gomodular_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/krishpranav/gomodular"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	shapeType    = reflect.TypeOf((*Shape)(nil)).Elem()
+	databaseType = reflect.TypeOf((*Database)(nil)).Elem()
+)
+
+func TestGomodular_Has(t *testing.T) {
+	c := gomodular.New()
+
+	err := c.NamedSingleton("rounded", func() Shape {
+		return &Circle{}
+	})
+	assert.NoError(t, err)
+
+	assert.True(t, c.Has(shapeType, "rounded"))
+	assert.False(t, c.Has(shapeType, ""))
+	assert.False(t, c.Has(databaseType, "rounded"))
+	assert.True(t, c.Scope().Has(shapeType, "rounded"))
+}
+
+func TestGomodular_Bindings(t *testing.T) {
+	c := gomodular.New()
+
+	err := c.Singleton(func() Shape {
+		return &Circle{}
+	})
+	assert.NoError(t, err)
+
+	gomodular.MustNamedBindTransientLazy[Database](c, "sql", func() Database {
+		return &MySQL{}
+	})
+
+	bindings := c.Bindings()
+	if assert.Len(t, bindings, 2) {
+		assert.Equal(t, shapeType, bindings[0].Type)
+		assert.Equal(t, "", bindings[0].Name)
+		assert.Equal(t, "singleton", bindings[0].Lifetime)
+		assert.False(t, bindings[0].Lazy)
+		assert.True(t, bindings[0].Instantiated)
+		assert.Regexp(t, `inspect_test\.go:\d+$`, bindings[0].Source)
+
+		assert.Equal(t, databaseType, bindings[1].Type)
+		assert.Equal(t, "sql", bindings[1].Name)
+		assert.Equal(t, "transient", bindings[1].Lifetime)
+		assert.True(t, bindings[1].Lazy)
+		assert.False(t, bindings[1].Instantiated)
+		assert.Regexp(t, `inspect_test\.go:\d+$`, bindings[1].Source)
+	}
+}
+
+func TestGomodular_Unbind(t *testing.T) {
+	c := gomodular.New()
+
+	err := c.Singleton(func() Shape {
+		return &Circle{}
+	})
+	assert.NoError(t, err)
+
+	scope := c.Scope()
+	assert.False(t, scope.Unbind(shapeType, ""))
+	assert.True(t, scope.Has(shapeType, ""))
+
+	assert.True(t, c.Unbind(shapeType, ""))
+	assert.False(t, c.Unbind(shapeType, ""))
+	assert.False(t, c.Has(shapeType, ""))
+	assert.Empty(t, c.Bindings())
+
+	var s Shape
+	assert.ErrorIs(t, c.Resolve(&s), gomodular.ErrNotFound)
+}
+
+func TestGomodular_OnReplace(t *testing.T) {
+	c := gomodular.New()
+
+	var replaced []gomodular.BindingInfo
+	c.OnReplace(func(previous, replacement gomodular.BindingInfo) {
+		replaced = append(replaced, previous, replacement)
+	})
+
+	err := c.Singleton(func() Shape {
+		return &Circle{}
+	})
+	assert.NoError(t, err)
+	assert.Empty(t, replaced)
+
+	err = c.Scope().TransientLazy(func() Shape {
+		return &Circle{a: 2}
+	})
+	assert.NoError(t, err)
+	assert.Empty(t, replaced)
+
+	err = c.TransientLazy(func() Shape {
+		return &Circle{a: 2}
+	})
+	assert.NoError(t, err)
+
+	if assert.Len(t, replaced, 2) {
+		assert.Equal(t, "singleton", replaced[0].Lifetime)
+		assert.Regexp(t, `inspect_test\.go:\d+$`, replaced[0].Source)
+		assert.Equal(t, "transient", replaced[1].Lifetime)
+		assert.Regexp(t, `inspect_test\.go:\d+$`, replaced[1].Source)
+	}
+}
+
+func TestGomodular_OnReplace_Is_Inherited_By_Scopes(t *testing.T) {
+	c := gomodular.New()
+
+	calls := 0
+	c.OnReplace(func(previous, replacement gomodular.BindingInfo) {
+		calls++
+	})
+
+	scope := c.Scope()
+	for i := 0; i < 2; i++ {
+		err := scope.Singleton(func() Shape {
+			return &Circle{}
+		})
+		assert.NoError(t, err)
+	}
+
+	assert.Equal(t, 1, calls)
+}
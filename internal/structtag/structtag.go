@@ -0,0 +1,56 @@
+package structtag
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+)
+
+type Tag struct {
+	Name     string
+	Optional bool
+	Lazy     bool
+}
+
+func Parse(field, value string) (Tag, error) {
+	var t Tag
+	seen := make(map[string]bool)
+	for _, entry := range strings.Split(value, ",") {
+		key, val, hasValue := strings.Cut(strings.TrimSpace(entry), "=")
+		key = strings.TrimSpace(key)
+		val = strings.TrimSpace(val)
+
+		if key == "" {
+			return Tag{}, errors.New("empty key")
+		}
+		if seen[key] || (key == "type" && seen["name"]) || (key == "name" && seen["type"]) {
+			return Tag{}, errors.New("duplicate key " + strconv.Quote(key))
+		}
+		seen[key] = true
+
+		switch key {
+		case "type":
+			if hasValue {
+				return Tag{}, errors.New("type takes no value")
+			}
+		case "name":
+			if !hasValue {
+				t.Name = field
+			} else if val == "" {
+				return Tag{}, errors.New("empty name")
+			} else {
+				t.Name = val
+			}
+		case "optional", "lazy":
+			if hasValue {
+				return Tag{}, errors.New(key + " takes no value")
+			}
+			t.Optional = t.Optional || key == "optional"
+			t.Lazy = t.Lazy || key == "lazy"
+		default:
+			return Tag{}, errors.New("unknown key " + strconv.Quote(key))
+		}
+	}
+
+	return t, nil
+}
@@ -0,0 +1,122 @@
+package gomodular
+
+import (
+	"context"
+	"reflect"
+	"sync"
+	"time"
+)
+
+var lifecycleType = reflect.TypeOf((*Lifecycle)(nil)).Elem()
+
+type Hook struct {
+	OnStart func(ctx context.Context) error
+	OnStop  func(ctx context.Context) error
+	Timeout time.Duration
+}
+
+type Lifecycle interface {
+	Append(hook Hook)
+}
+
+type lifecycle struct {
+	run     sync.Mutex
+	mu      sync.Mutex
+	hooks   []Hook
+	started int
+}
+
+func (l *lifecycle) Append(hook Hook) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.hooks = append(l.hooks, hook)
+}
+
+func (l *lifecycle) count() int {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	return len(l.hooks)
+}
+
+func (l *lifecycle) truncate(n int) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if n < len(l.hooks) {
+		l.hooks = l.hooks[:n:n]
+	}
+}
+
+func (l *lifecycle) hook(i int) (Hook, bool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if i < 0 || i >= len(l.hooks) {
+		return Hook{}, false
+	}
+	return l.hooks[i], true
+}
+
+func (l *lifecycle) start(ctx context.Context) error {
+	l.run.Lock()
+	defer l.run.Unlock()
+
+	for hook, ok := l.hook(l.started); ok; hook, ok = l.hook(l.started) {
+		if hook.OnStart != nil {
+			if err := runHook(ctx, hook.OnStart, hook.Timeout); err != nil {
+				errs := append([]error{err}, l.stop(ctx)...)
+				return newMultiError(errs)
+			}
+		}
+		l.started++
+	}
+
+	return nil
+}
+
+func (l *lifecycle) stop(ctx context.Context) []error {
+	var errs []error
+	for ; l.started > 0; l.started-- {
+		hook, _ := l.hook(l.started - 1)
+		if hook.OnStop != nil {
+			if err := runHook(ctx, hook.OnStop, hook.Timeout); err != nil {
+				errs = append(errs, err)
+			}
+		}
+	}
+
+	return errs
+}
+
+func runHook(ctx context.Context, fn func(context.Context) error, timeout time.Duration) error {
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+
+	done := make(chan error, 1)
+	go func() {
+		done <- fn(ctx)
+	}()
+
+	select {
+	case err := <-done:
+		return err
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (c *Gomodular) Start(ctx context.Context) error {
+	return c.lifecycle.start(ctx)
+}
+
+func (c *Gomodular) Stop(ctx context.Context) error {
+	c.lifecycle.run.Lock()
+	defer c.lifecycle.run.Unlock()
+
+	return newMultiError(c.lifecycle.stop(ctx))
+}
@@ -0,0 +1,122 @@
+package gomodular_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/krishpranav/gomodular"
+	"github.com/stretchr/testify/assert"
+)
+
+func record(events *[]string, event string) func(context.Context) error {
+	return func(context.Context) error {
+		*events = append(*events, event)
+		return nil
+	}
+}
+
+func TestGomodular_Start_And_Stop_In_Dependency_Order(t *testing.T) {
+	c := gomodular.New()
+	var events []string
+
+	err := c.SingletonLazy(func(lc gomodular.Lifecycle, db Database) Shape {
+		lc.Append(gomodular.Hook{
+			OnStart: record(&events, "start shape"),
+			OnStop:  record(&events, "stop shape"),
+		})
+		return &Circle{}
+	})
+	assert.NoError(t, err)
+
+	err = c.SingletonLazy(func(lc gomodular.Lifecycle) Database {
+		lc.Append(gomodular.Hook{
+			OnStart: record(&events, "start db"),
+			OnStop:  record(&events, "stop db"),
+		})
+		return &MySQL{}
+	})
+	assert.NoError(t, err)
+
+	var s Shape
+	assert.NoError(t, c.Resolve(&s))
+
+	assert.NoError(t, c.Start(context.Background()))
+	assert.Equal(t, []string{"start db", "start shape"}, events)
+
+	assert.NoError(t, c.Stop(context.Background()))
+	assert.Equal(t, []string{"start db", "start shape", "stop shape", "stop db"}, events)
+}
+
+func TestGomodular_Start_Rolls_Back_On_Failure(t *testing.T) {
+	c := gomodular.New()
+	var events []string
+
+	startErr := errors.New("app: cannot start")
+	err := c.Call(func(lc gomodular.Lifecycle) {
+		lc.Append(gomodular.Hook{
+			OnStart: record(&events, "start first"),
+			OnStop:  record(&events, "stop first"),
+		})
+		lc.Append(gomodular.Hook{
+			OnStart: func(context.Context) error {
+				return startErr
+			},
+			OnStop: record(&events, "stop second"),
+		})
+		lc.Append(gomodular.Hook{
+			OnStart: record(&events, "start third"),
+		})
+	})
+	assert.NoError(t, err)
+
+	err = c.Start(context.Background())
+	assert.ErrorIs(t, err, startErr)
+	assert.Equal(t, []string{"start first", "stop first"}, events)
+
+	assert.NoError(t, c.Stop(context.Background()))
+	assert.Equal(t, []string{"start first", "stop first"}, events)
+}
+
+func TestGomodular_Start_With_Hook_Timeout(t *testing.T) {
+	c := gomodular.New()
+
+	err := c.Call(func(lc gomodular.Lifecycle) {
+		lc.Append(gomodular.Hook{
+			OnStart: func(ctx context.Context) error {
+				<-ctx.Done()
+				return ctx.Err()
+			},
+			Timeout: 10 * time.Millisecond,
+		})
+	})
+	assert.NoError(t, err)
+
+	err = c.Start(context.Background())
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+func TestGomodular_Stop_Collects_Errors(t *testing.T) {
+	c := gomodular.New()
+
+	stopErr := errors.New("app: cannot stop")
+	err := c.Call(func(lc gomodular.Lifecycle) {
+		lc.Append(gomodular.Hook{
+			OnStop: func(context.Context) error {
+				return stopErr
+			},
+		})
+		lc.Append(gomodular.Hook{
+			OnStop: func(context.Context) error {
+				return stopErr
+			},
+		})
+	})
+	assert.NoError(t, err)
+
+	assert.NoError(t, c.Start(context.Background()))
+
+	err = c.Stop(context.Background())
+	assert.EqualError(t, err, "app: cannot stop; app: cannot stop")
+}
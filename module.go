@@ -0,0 +1,149 @@
+package gomodular
+
+type Module struct {
+	Name      string
+	Imports   []*Module
+	Providers []Provider
+	Invokes   []interface{}
+}
+
+type Provider struct {
+	Resolver interface{}
+	Name     string
+	Lifetime string
+	Lazy     bool
+	Private  bool
+}
+
+func (c *Gomodular) Install(modules ...*Module) error {
+	for _, m := range modules {
+		if err := c.install(m); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (c *Gomodular) install(m *Module) error {
+	c.mu.Lock()
+	if c.installed == nil {
+		c.installed = make(map[*Module]bool)
+	}
+	if c.installed[m] {
+		c.mu.Unlock()
+		return nil
+	}
+	c.installed[m] = true
+	c.mu.Unlock()
+
+	if err := c.installModule(m); err != nil {
+		c.mu.Lock()
+		delete(c.installed, m)
+		c.mu.Unlock()
+		return err
+	}
+
+	return nil
+}
+
+func (c *Gomodular) installModule(m *Module) error {
+	for _, imported := range m.Imports {
+		if err := c.install(imported); err != nil {
+			return err
+		}
+	}
+
+	scope := New(WithParent(c))
+	scope.options = c.options
+	scope.lifecycle = c.lifecycle
+	scope.module = m.Name
+	hooks := c.lifecycle.count()
+
+	c.mu.Lock()
+	c.modules = append(c.modules, scope)
+	c.mu.Unlock()
+
+	for _, p := range m.Providers {
+		if err := c.provide(scope, p); err != nil {
+			c.uninstall(scope, hooks)
+			return &ModuleError{Module: m.Name, Err: err}
+		}
+	}
+
+	for _, function := range m.Invokes {
+		if err := scope.Call(function); err != nil {
+			c.uninstall(scope, hooks)
+			return &ModuleError{Module: m.Name, Err: err}
+		}
+	}
+
+	return nil
+}
+
+func (c *Gomodular) uninstall(scope *Gomodular, hooks int) {
+	c.lifecycle.truncate(hooks)
+
+	scope.mu.Lock()
+	closers := scope.closers
+	overwritten := scope.overwritten
+	scope.closers = nil
+	scope.overwritten = nil
+	scope.mu.Unlock()
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for i, module := range c.modules {
+		if module == scope {
+			c.modules = append(c.modules[:i:i], c.modules[i+1:]...)
+			break
+		}
+	}
+
+	for abstraction, bindings := range c.bindings {
+		for name, b := range bindings {
+			if b.module == scope {
+				delete(bindings, name)
+			}
+		}
+		if len(bindings) == 0 {
+			delete(c.bindings, abstraction)
+		}
+	}
+
+	for i := len(overwritten) - 1; i >= 0; i-- {
+		e := overwritten[i]
+		if _, exist := c.bindings[e.abstraction]; !exist {
+			c.bindings[e.abstraction] = make(map[string]*binding)
+		}
+		c.bindings[e.abstraction][e.name] = e.binding
+	}
+
+	c.closers = append(c.closers, closers...)
+}
+
+func (c *Gomodular) provide(scope *Gomodular, p Provider) error {
+	lifetime := p.Lifetime
+	if lifetime == "" {
+		lifetime = c.options.lifetime
+	}
+
+	b, err := newBinding(p.Resolver, lifetime, p.Lazy)
+	if err != nil {
+		return err
+	}
+	b.module = scope
+
+	if p.Private {
+		return scope.define(p.Resolver, p.Name, b, false)
+	}
+	return c.define(p.Resolver, p.Name, b, false)
+}
+
+func (c *Gomodular) installedModules() []*Gomodular {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return append([]*Gomodular{}, c.modules...)
+}
@@ -0,0 +1,247 @@
+package gomodular_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/krishpranav/gomodular"
+	"github.com/stretchr/testify/assert"
+)
+
+var storageModule = &gomodular.Module{
+	Name: "storage",
+	Providers: []gomodular.Provider{
+		{Resolver: func() Shape { return &Circle{a: 13} }, Private: true},
+		{Resolver: func(s Shape) Database { return &MySQL{} }, Lazy: true},
+	},
+}
+
+func TestGomodular_Install(t *testing.T) {
+	c := gomodular.New()
+
+	invoked := false
+	app := &gomodular.Module{
+		Name:    "app",
+		Imports: []*gomodular.Module{storageModule},
+		Invokes: []interface{}{
+			func(db Database) {
+				invoked = true
+			},
+		},
+	}
+
+	err := c.Install(app, storageModule)
+	assert.NoError(t, err)
+	assert.True(t, invoked)
+
+	var db Database
+	assert.NoError(t, c.Resolve(&db))
+
+	var s Shape
+	assert.ErrorIs(t, c.Resolve(&s), gomodular.ErrNotFound)
+	assert.False(t, c.Has(shapeType, ""))
+	assert.Len(t, c.Bindings(), 1)
+
+	assert.NoError(t, c.Validate())
+}
+
+func TestGomodular_Install_Keeps_Private_Bindings_Apart(t *testing.T) {
+	c := gomodular.New(gomodular.WithStrictBindings())
+
+	circle := &gomodular.Module{
+		Name: "circle",
+		Providers: []gomodular.Provider{
+			{Resolver: func() Shape { return &Circle{a: 1} }, Private: true},
+			{Resolver: func(s Shape) int { return s.GetArea() }, Name: "circle"},
+		},
+	}
+	square := &gomodular.Module{
+		Name: "square",
+		Providers: []gomodular.Provider{
+			{Resolver: func() Shape { return &Circle{a: 4} }, Private: true},
+			{Resolver: func(s Shape) int { return s.GetArea() }, Name: "square"},
+		},
+	}
+
+	err := c.Install(circle, square)
+	assert.NoError(t, err)
+
+	var area int
+	assert.NoError(t, c.NamedResolve(&area, "circle"))
+	assert.Equal(t, 1, area)
+	assert.NoError(t, c.NamedResolve(&area, "square"))
+	assert.Equal(t, 4, area)
+}
+
+func TestGomodular_Install_Can_Be_Overridden(t *testing.T) {
+	c := gomodular.New()
+
+	err := c.Install(storageModule)
+	assert.NoError(t, err)
+
+	replaced := false
+	err = c.Replace(func(s Shape) Database {
+		replaced = s.GetArea() == 13
+		return &MySQL{}
+	})
+	assert.NoError(t, err)
+
+	var db Database
+	assert.NoError(t, c.Resolve(&db))
+	assert.True(t, replaced)
+}
+
+func TestGomodular_Install_With_Errors(t *testing.T) {
+	c := gomodular.New()
+
+	broken := &gomodular.Module{
+		Name: "broken",
+		Providers: []gomodular.Provider{
+			{Resolver: func(s Shape) Database { return &MySQL{} }},
+		},
+	}
+
+	err := c.Install(broken)
+	assert.ErrorIs(t, err, gomodular.ErrNotFound)
+
+	var moduleErr *gomodular.ModuleError
+	if assert.ErrorAs(t, err, &moduleErr) {
+		assert.Equal(t, "broken", moduleErr.Module)
+	}
+
+	err = c.Install(&gomodular.Module{
+		Name: "lifetime",
+		Providers: []gomodular.Provider{
+			{Resolver: func() Shape { return &Circle{} }, Lifetime: "forever"},
+		},
+	})
+	assert.ErrorIs(t, err, gomodular.ErrInvalidResolver)
+
+	err = c.Install(&gomodular.Module{
+		Name:    "invoke",
+		Invokes: []interface{}{func(s Shape) {}},
+	})
+	assert.ErrorIs(t, err, gomodular.ErrNotFound)
+}
+
+func TestGomodular_Install_Runs_Hooks_And_Closes_Module_Instances(t *testing.T) {
+	c := gomodular.New()
+	var started, closed []string
+
+	hook := func(lc gomodular.Lifecycle, name string) {
+		lc.Append(gomodular.Hook{
+			OnStart: func(ctx context.Context) error {
+				started = append(started, name)
+				return nil
+			},
+		})
+	}
+
+	err := c.Install(&gomodular.Module{
+		Name: "storage",
+		Providers: []gomodular.Provider{
+			{Resolver: func(lc gomodular.Lifecycle) Database {
+				hook(lc, "private")
+				return &Pool{name: "private", closed: &closed}
+			}, Private: true},
+			{Resolver: func(db Database, lc gomodular.Lifecycle) Shape {
+				hook(lc, "exported")
+				return &Circle{}
+			}},
+		},
+	})
+	assert.NoError(t, err)
+
+	assert.NoError(t, c.Start(context.Background()))
+	assert.Equal(t, []string{"private", "exported"}, started)
+
+	assert.NoError(t, c.Close(context.Background()))
+	assert.Equal(t, []string{"private"}, closed)
+}
+
+func TestGomodular_Install_Can_Be_Retried_After_Errors(t *testing.T) {
+	c := gomodular.New(gomodular.WithStrictBindings())
+
+	m := &gomodular.Module{
+		Name: "storage",
+		Providers: []gomodular.Provider{
+			{Resolver: func() Database { return &MySQL{} }},
+		},
+		Invokes: []interface{}{func(s Shape) {}},
+	}
+
+	err := c.Install(m)
+	assert.ErrorIs(t, err, gomodular.ErrNotFound)
+	assert.False(t, c.Has(databaseType, ""))
+
+	err = c.Singleton(func() Shape {
+		return &Circle{}
+	})
+	assert.NoError(t, err)
+
+	assert.NoError(t, c.Install(m))
+
+	var db Database
+	assert.NoError(t, c.Resolve(&db))
+	assert.NoError(t, c.Validate())
+}
+
+func TestGomodular_Install_Restores_Overwritten_Bindings_After_Errors(t *testing.T) {
+	c := gomodular.New()
+
+	err := c.Singleton(func() Shape {
+		return &Circle{a: 13}
+	})
+	assert.NoError(t, err)
+
+	err = c.Install(&gomodular.Module{
+		Name: "shapes",
+		Providers: []gomodular.Provider{
+			{Resolver: func() Shape { return &Circle{a: 1} }},
+			{Resolver: func() Shape { return &Circle{a: 2} }},
+			{Resolver: func(db Database) int { return 0 }},
+		},
+	})
+	assert.ErrorIs(t, err, gomodular.ErrNotFound)
+
+	var s Shape
+	assert.NoError(t, c.Resolve(&s))
+	assert.Equal(t, 13, s.GetArea())
+	assert.Len(t, c.Bindings(), 1)
+}
+
+func TestGomodular_Install_Drops_Hooks_After_Errors(t *testing.T) {
+	c := gomodular.New()
+	var started []string
+
+	retried := false
+	m := &gomodular.Module{
+		Name: "storage",
+		Providers: []gomodular.Provider{
+			{Resolver: func(lc gomodular.Lifecycle) Database {
+				lc.Append(gomodular.Hook{
+					OnStart: func(ctx context.Context) error {
+						started = append(started, "storage")
+						return nil
+					},
+				})
+				return &MySQL{}
+			}},
+		},
+		Invokes: []interface{}{func() error {
+			if !retried {
+				retried = true
+				return errors.New("app: not ready")
+			}
+			return nil
+		}},
+	}
+
+	err := c.Install(m)
+	assert.EqualError(t, err, "gomodular: module \"storage\" failed: app: not ready")
+
+	assert.NoError(t, c.Install(m))
+	assert.NoError(t, c.Start(context.Background()))
+	assert.Equal(t, []string{"storage"}, started)
+}
@@ -1,73 +1,313 @@
 package gomodular
 
-func MustSingleton(c Gomodular, resolver interface{}) {
+import "context"
+
+func MustSingleton(c *Gomodular, resolver interface{}) {
 	if err := c.Singleton(resolver); err != nil {
 		panic(err)
 	}
 }
 
-func MustSingletonLazy(c Gomodular, resolver interface{}) {
+func MustSingletonLazy(c *Gomodular, resolver interface{}) {
 	if err := c.SingletonLazy(resolver); err != nil {
 		panic(err)
 	}
 }
 
-func MustNamedSingleton(c Gomodular, name string, resolver interface{}) {
+func MustNamedSingleton(c *Gomodular, name string, resolver interface{}) {
 	if err := c.NamedSingleton(name, resolver); err != nil {
 		panic(err)
 	}
 }
 
-func MustNamedSingletonLazy(c Gomodular, name string, resolver interface{}) {
+func MustNamedSingletonLazy(c *Gomodular, name string, resolver interface{}) {
 	if err := c.NamedSingletonLazy(name, resolver); err != nil {
 		panic(err)
 	}
 }
 
-func MustTransient(c Gomodular, resolver interface{}) {
+func MustTransient(c *Gomodular, resolver interface{}) {
 	if err := c.Transient(resolver); err != nil {
 		panic(err)
 	}
 }
 
-func MustTransientLazy(c Gomodular, resolver interface{}) {
+func MustTransientLazy(c *Gomodular, resolver interface{}) {
 	if err := c.TransientLazy(resolver); err != nil {
 		panic(err)
 	}
 }
 
-func MustNamedTransient(c Gomodular, name string, resolver interface{}) {
+func MustNamedTransient(c *Gomodular, name string, resolver interface{}) {
 	if err := c.NamedTransient(name, resolver); err != nil {
 		panic(err)
 	}
 }
 
-func MustNamedTransientLazy(c Gomodular, name string, resolver interface{}) {
+func MustNamedTransientLazy(c *Gomodular, name string, resolver interface{}) {
 	if err := c.NamedTransientLazy(name, resolver); err != nil {
 		panic(err)
 	}
 }
 
-func MustCall(c Gomodular, receiver interface{}) {
+func MustScoped(c *Gomodular, resolver interface{}) {
+	if err := c.Scoped(resolver); err != nil {
+		panic(err)
+	}
+}
+
+func MustNamedScoped(c *Gomodular, name string, resolver interface{}) {
+	if err := c.NamedScoped(name, resolver); err != nil {
+		panic(err)
+	}
+}
+
+func MustRegister(c *Gomodular, resolver interface{}) {
+	if err := c.Register(resolver); err != nil {
+		panic(err)
+	}
+}
+
+func MustNamedRegister(c *Gomodular, name string, resolver interface{}) {
+	if err := c.NamedRegister(name, resolver); err != nil {
+		panic(err)
+	}
+}
+
+func MustContribute(c *Gomodular, resolver interface{}) {
+	if err := c.Contribute(resolver); err != nil {
+		panic(err)
+	}
+}
+
+func MustInstall(c *Gomodular, modules ...*Module) {
+	if err := c.Install(modules...); err != nil {
+		panic(err)
+	}
+}
+
+func MustReplace(c *Gomodular, resolver interface{}) {
+	if err := c.Replace(resolver); err != nil {
+		panic(err)
+	}
+}
+
+func MustNamedReplace(c *Gomodular, name string, resolver interface{}) {
+	if err := c.NamedReplace(name, resolver); err != nil {
+		panic(err)
+	}
+}
+
+func MustDecorate(c *Gomodular, decorator interface{}) {
+	if err := c.Decorate(decorator); err != nil {
+		panic(err)
+	}
+}
+
+func MustNamedDecorate(c *Gomodular, name string, decorator interface{}) {
+	if err := c.NamedDecorate(name, decorator); err != nil {
+		panic(err)
+	}
+}
+
+func MustCall(c *Gomodular, receiver interface{}) {
 	if err := c.Call(receiver); err != nil {
 		panic(err)
 	}
 }
 
-func MustResolve(c Gomodular, abstraction interface{}) {
+func MustResolve(c *Gomodular, abstraction interface{}) {
 	if err := c.Resolve(abstraction); err != nil {
 		panic(err)
 	}
 }
 
-func MustNamedResolve(c Gomodular, abstraction interface{}, name string) {
+func MustNamedResolve(c *Gomodular, abstraction interface{}, name string) {
 	if err := c.NamedResolve(abstraction, name); err != nil {
 		panic(err)
 	}
 }
 
-func MustFill(c Gomodular, receiver interface{}) {
+func MustFill(c *Gomodular, receiver interface{}) {
 	if err := c.Fill(receiver); err != nil {
 		panic(err)
 	}
 }
+
+func MustCallContext(c *Gomodular, ctx context.Context, receiver interface{}) {
+	if err := c.CallContext(ctx, receiver); err != nil {
+		panic(err)
+	}
+}
+
+func MustResolveContext(c *Gomodular, ctx context.Context, abstraction interface{}) {
+	if err := c.ResolveContext(ctx, abstraction); err != nil {
+		panic(err)
+	}
+}
+
+func MustNamedResolveContext(c *Gomodular, ctx context.Context, abstraction interface{}, name string) {
+	if err := c.NamedResolveContext(ctx, abstraction, name); err != nil {
+		panic(err)
+	}
+}
+
+func MustFillContext(c *Gomodular, ctx context.Context, receiver interface{}) {
+	if err := c.FillContext(ctx, receiver); err != nil {
+		panic(err)
+	}
+}
+
+func MustFillRecursive(c *Gomodular, receiver interface{}) {
+	if err := c.FillRecursive(receiver); err != nil {
+		panic(err)
+	}
+}
+
+func MustBuild(c *Gomodular) {
+	if err := c.Build(); err != nil {
+		panic(err)
+	}
+}
+
+func MustBuildParallel(c *Gomodular, ctx context.Context, maxWorkers int) {
+	if err := c.BuildParallel(ctx, maxWorkers); err != nil {
+		panic(err)
+	}
+}
+
+func MustValidate(c *Gomodular) {
+	if err := c.Validate(); err != nil {
+		panic(err)
+	}
+}
+
+func MustStart(c *Gomodular, ctx context.Context) {
+	if err := c.Start(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func MustStop(c *Gomodular, ctx context.Context) {
+	if err := c.Stop(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func MustClose(c *Gomodular, ctx context.Context) {
+	if err := c.Close(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func MustBind[T any](c *Gomodular, resolver interface{}) {
+	if err := Bind[T](c, resolver); err != nil {
+		panic(err)
+	}
+}
+
+func MustBindLazy[T any](c *Gomodular, resolver interface{}) {
+	if err := BindLazy[T](c, resolver); err != nil {
+		panic(err)
+	}
+}
+
+func MustNamedBind[T any](c *Gomodular, name string, resolver interface{}) {
+	if err := NamedBind[T](c, name, resolver); err != nil {
+		panic(err)
+	}
+}
+
+func MustNamedBindLazy[T any](c *Gomodular, name string, resolver interface{}) {
+	if err := NamedBindLazy[T](c, name, resolver); err != nil {
+		panic(err)
+	}
+}
+
+func MustBindFunc[T any, R Constructor[T]](c *Gomodular, resolver R) {
+	if err := BindFunc[T](c, resolver); err != nil {
+		panic(err)
+	}
+}
+
+func MustNamedBindFunc[T any, R Constructor[T]](c *Gomodular, name string, resolver R) {
+	if err := NamedBindFunc[T](c, name, resolver); err != nil {
+		panic(err)
+	}
+}
+
+func MustBindLazyFunc[T any, R Constructor[T]](c *Gomodular, resolver R) {
+	if err := BindLazyFunc[T](c, resolver); err != nil {
+		panic(err)
+	}
+}
+
+func MustNamedBindLazyFunc[T any, R Constructor[T]](c *Gomodular, name string, resolver R) {
+	if err := NamedBindLazyFunc[T](c, name, resolver); err != nil {
+		panic(err)
+	}
+}
+
+func MustBindTransientFunc[T any, R Constructor[T]](c *Gomodular, resolver R) {
+	if err := BindTransientFunc[T](c, resolver); err != nil {
+		panic(err)
+	}
+}
+
+func MustNamedBindTransientFunc[T any, R Constructor[T]](c *Gomodular, name string, resolver R) {
+	if err := NamedBindTransientFunc[T](c, name, resolver); err != nil {
+		panic(err)
+	}
+}
+
+func MustBindTransientLazyFunc[T any, R Constructor[T]](c *Gomodular, resolver R) {
+	if err := BindTransientLazyFunc[T](c, resolver); err != nil {
+		panic(err)
+	}
+}
+
+func MustNamedBindTransientLazyFunc[T any, R Constructor[T]](c *Gomodular, name string, resolver R) {
+	if err := NamedBindTransientLazyFunc[T](c, name, resolver); err != nil {
+		panic(err)
+	}
+}
+
+func MustBindTransient[T any](c *Gomodular, resolver interface{}) {
+	if err := BindTransient[T](c, resolver); err != nil {
+		panic(err)
+	}
+}
+
+func MustBindTransientLazy[T any](c *Gomodular, resolver interface{}) {
+	if err := BindTransientLazy[T](c, resolver); err != nil {
+		panic(err)
+	}
+}
+
+func MustNamedBindTransient[T any](c *Gomodular, name string, resolver interface{}) {
+	if err := NamedBindTransient[T](c, name, resolver); err != nil {
+		panic(err)
+	}
+}
+
+func MustNamedBindTransientLazy[T any](c *Gomodular, name string, resolver interface{}) {
+	if err := NamedBindTransientLazy[T](c, name, resolver); err != nil {
+		panic(err)
+	}
+}
+
+func MustResolveAs[T any](c *Gomodular) T {
+	abstraction, err := ResolveAs[T](c)
+	if err != nil {
+		panic(err)
+	}
+	return abstraction
+}
+
+func MustNamedResolveAs[T any](c *Gomodular, name string) T {
+	abstraction, err := NamedResolveAs[T](c, name)
+	if err != nil {
+		panic(err)
+	}
+	return abstraction
+}
@@ -1,6 +1,7 @@
 package gomodular_test
 
 import (
+	"context"
 	"errors"
 	"testing"
 
@@ -97,6 +98,94 @@ func TestMustNamedTransientLazy_It_Should_Panic_On_Error(t *testing.T) {
 	t.Errorf("panic expcted.")
 }
 
+func TestMustScoped_It_Should_Panic_On_Error(t *testing.T) {
+	c := gomodular.New()
+
+	defer func() { recover() }()
+	gomodular.MustScoped(c, func() {})
+	t.Errorf("panic expcted.")
+}
+
+func TestMustNamedScoped_It_Should_Panic_On_Error(t *testing.T) {
+	c := gomodular.New()
+
+	defer func() { recover() }()
+	gomodular.MustNamedScoped(c, "name", func() {})
+	t.Errorf("panic expcted.")
+}
+
+func TestMustRegister_It_Should_Panic_On_Error(t *testing.T) {
+	c := gomodular.New()
+
+	defer func() { recover() }()
+	gomodular.MustRegister(c, func() {})
+	t.Errorf("panic expcted.")
+}
+
+func TestMustContribute_It_Should_Panic_On_Error(t *testing.T) {
+	c := gomodular.New()
+
+	defer func() { recover() }()
+	gomodular.MustContribute(c, func() {})
+	t.Errorf("panic expcted.")
+}
+
+func TestMustNamedRegister_It_Should_Panic_On_Error(t *testing.T) {
+	c := gomodular.New()
+
+	defer func() { recover() }()
+	gomodular.MustNamedRegister(c, "name", func() {})
+	t.Errorf("panic expcted.")
+}
+
+func TestMustInstall_It_Should_Panic_On_Error(t *testing.T) {
+	c := gomodular.New()
+
+	defer func() { recover() }()
+	gomodular.MustInstall(c, &gomodular.Module{Invokes: []interface{}{func(s Shape) {}}})
+	t.Errorf("panic expcted.")
+}
+
+func TestMustReplace_It_Should_Panic_On_Error(t *testing.T) {
+	c := gomodular.New()
+
+	defer func() { recover() }()
+	gomodular.MustReplace(c, func() Shape {
+		return &Circle{}
+	})
+	t.Errorf("panic expcted.")
+}
+
+func TestMustNamedReplace_It_Should_Panic_On_Error(t *testing.T) {
+	c := gomodular.New()
+
+	defer func() { recover() }()
+	gomodular.MustNamedReplace(c, "name", func() Shape {
+		return &Circle{}
+	})
+	t.Errorf("panic expcted.")
+}
+
+func TestMustDecorate_It_Should_Panic_On_Error(t *testing.T) {
+	c := gomodular.New()
+
+	defer func() { recover() }()
+	gomodular.MustDecorate(c, func(s Shape) Shape {
+		return s
+	})
+	t.Errorf("panic expcted.")
+}
+
+func TestMustNamedDecorate_It_Should_Panic_On_Error(t *testing.T) {
+	c := gomodular.New()
+
+	defer func() { recover() }()
+	gomodular.MustNamedDecorate(c, "name", func(s Shape) Shape {
+		return s
+	})
+	t.Errorf("panic expcted.")
+}
+
 func TestMustCall_It_Should_Panic_On_Error(t *testing.T) {
 	c := gomodular.New()
 
@@ -138,3 +227,286 @@ func TestMustFill_It_Should_Panic_On_Error(t *testing.T) {
 	gomodular.MustFill(c, &myApp)
 	t.Errorf("panic expcted.")
 }
+
+func TestMustCallContext_It_Should_Panic_On_Error(t *testing.T) {
+	c := gomodular.New()
+
+	defer func() { recover() }()
+	gomodular.MustCallContext(c, context.Background(), func(s Shape) {})
+	t.Errorf("panic expcted.")
+}
+
+func TestMustResolveContext_It_Should_Panic_On_Error(t *testing.T) {
+	c := gomodular.New()
+
+	var s Shape
+
+	defer func() { recover() }()
+	gomodular.MustResolveContext(c, context.Background(), &s)
+	t.Errorf("panic expcted.")
+}
+
+func TestMustNamedResolveContext_It_Should_Panic_On_Error(t *testing.T) {
+	c := gomodular.New()
+
+	var s Shape
+
+	defer func() { recover() }()
+	gomodular.MustNamedResolveContext(c, context.Background(), &s, "name")
+	t.Errorf("panic expcted.")
+}
+
+func TestMustFillContext_It_Should_Panic_On_Error(t *testing.T) {
+	c := gomodular.New()
+
+	myApp := struct {
+		S Shape `gomodular:"type"`
+	}{}
+
+	defer func() { recover() }()
+	gomodular.MustFillContext(c, context.Background(), &myApp)
+	t.Errorf("panic expcted.")
+}
+
+func TestMustFillRecursive_It_Should_Panic_On_Error(t *testing.T) {
+	c := gomodular.New()
+
+	myApp := struct {
+		Deps struct {
+			S Shape `gomodular:"type"`
+		}
+	}{}
+
+	defer func() { recover() }()
+	gomodular.MustFillRecursive(c, &myApp)
+	t.Errorf("panic expcted.")
+}
+
+func TestMustBuild_It_Should_Panic_On_Error(t *testing.T) {
+	c := gomodular.New(gomodular.WithDeferredBuild())
+
+	gomodular.MustSingleton(c, func(db Database) Shape {
+		return &Circle{}
+	})
+
+	defer func() { recover() }()
+	gomodular.MustBuild(c)
+	t.Errorf("panic expcted.")
+}
+
+func TestMustBuildParallel_It_Should_Panic_On_Error(t *testing.T) {
+	c := gomodular.New(gomodular.WithDeferredBuild())
+
+	gomodular.MustSingleton(c, func(db Database) Shape {
+		return &Circle{}
+	})
+
+	defer func() { recover() }()
+	gomodular.MustBuildParallel(c, context.Background(), 2)
+	t.Errorf("panic expcted.")
+}
+
+func TestMustValidate_It_Should_Panic_On_Error(t *testing.T) {
+	c := gomodular.New()
+
+	gomodular.MustSingletonLazy(c, func(db Database) Shape {
+		return &Circle{}
+	})
+
+	defer func() { recover() }()
+	gomodular.MustValidate(c)
+	t.Errorf("panic expcted.")
+}
+
+func TestMustStart_It_Should_Panic_On_Error(t *testing.T) {
+	c := gomodular.New()
+
+	gomodular.MustCall(c, func(lc gomodular.Lifecycle) {
+		lc.Append(gomodular.Hook{
+			OnStart: func(context.Context) error {
+				return errors.New("error")
+			},
+		})
+	})
+
+	defer func() { recover() }()
+	gomodular.MustStart(c, context.Background())
+	t.Errorf("panic expcted.")
+}
+
+func TestMustStop_It_Should_Panic_On_Error(t *testing.T) {
+	c := gomodular.New()
+
+	gomodular.MustCall(c, func(lc gomodular.Lifecycle) {
+		lc.Append(gomodular.Hook{
+			OnStop: func(context.Context) error {
+				return errors.New("error")
+			},
+		})
+	})
+	gomodular.MustStart(c, context.Background())
+
+	defer func() { recover() }()
+	gomodular.MustStop(c, context.Background())
+	t.Errorf("panic expcted.")
+}
+
+func TestMustClose_It_Should_Panic_On_Error(t *testing.T) {
+	c := gomodular.New()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	gomodular.MustSingleton(c, func() Shape {
+		return &Server{}
+	})
+
+	defer func() { recover() }()
+	gomodular.MustClose(c, ctx)
+	t.Errorf("panic expcted.")
+}
+
+func TestMustBind_It_Should_Panic_On_Error(t *testing.T) {
+	c := gomodular.New()
+
+	defer func() { recover() }()
+	gomodular.MustBind[Shape](c, func() Database {
+		return &MySQL{}
+	})
+	t.Errorf("panic expcted.")
+}
+
+func TestMustNamedBindLazy_It_Should_Panic_On_Error(t *testing.T) {
+	c := gomodular.New()
+
+	defer func() { recover() }()
+	gomodular.MustNamedBindLazy[Shape](c, "name", func() {})
+	t.Errorf("panic expcted.")
+}
+
+func TestMustBindFunc_It_Should_Panic_On_Error(t *testing.T) {
+	c := gomodular.New()
+
+	defer func() { recover() }()
+	gomodular.MustBindFunc[Shape](c, func() (Shape, error) {
+		return nil, errors.New("app: error")
+	})
+	t.Errorf("panic expcted.")
+}
+
+func TestMustNamedBindFunc_It_Should_Panic_On_Error(t *testing.T) {
+	c := gomodular.New()
+
+	defer func() { recover() }()
+	gomodular.MustNamedBindFunc[Shape](c, "name", func() (Shape, error) {
+		return nil, errors.New("app: error")
+	})
+	t.Errorf("panic expcted.")
+}
+
+func TestMustBindLazyFunc_It_Should_Panic_On_Error(t *testing.T) {
+	c := gomodular.New(gomodular.WithStrictBindings())
+	gomodular.MustBindLazyFunc[Shape](c, func() Shape {
+		return &Circle{}
+	})
+
+	defer func() { recover() }()
+	gomodular.MustBindLazyFunc[Shape](c, func() Shape {
+		return &Circle{}
+	})
+	t.Errorf("panic expcted.")
+}
+
+func TestMustNamedBindLazyFunc_It_Should_Panic_On_Error(t *testing.T) {
+	c := gomodular.New(gomodular.WithStrictBindings())
+	gomodular.MustNamedBindLazyFunc[Shape](c, "name", func() Shape {
+		return &Circle{}
+	})
+
+	defer func() { recover() }()
+	gomodular.MustNamedBindLazyFunc[Shape](c, "name", func() Shape {
+		return &Circle{}
+	})
+	t.Errorf("panic expcted.")
+}
+
+func TestMustBindTransientFunc_It_Should_Panic_On_Error(t *testing.T) {
+	c := gomodular.New(gomodular.WithStrictBindings())
+	gomodular.MustBindTransientFunc[Shape](c, func() Shape {
+		return &Circle{}
+	})
+
+	defer func() { recover() }()
+	gomodular.MustBindTransientFunc[Shape](c, func() Shape {
+		return &Circle{}
+	})
+	t.Errorf("panic expcted.")
+}
+
+func TestMustNamedBindTransientFunc_It_Should_Panic_On_Error(t *testing.T) {
+	c := gomodular.New(gomodular.WithStrictBindings())
+	gomodular.MustNamedBindTransientFunc[Shape](c, "name", func() Shape {
+		return &Circle{}
+	})
+
+	defer func() { recover() }()
+	gomodular.MustNamedBindTransientFunc[Shape](c, "name", func() Shape {
+		return &Circle{}
+	})
+	t.Errorf("panic expcted.")
+}
+
+func TestMustBindTransientLazyFunc_It_Should_Panic_On_Error(t *testing.T) {
+	c := gomodular.New(gomodular.WithStrictBindings())
+	gomodular.MustBindTransientLazyFunc[Shape](c, func() Shape {
+		return &Circle{}
+	})
+
+	defer func() { recover() }()
+	gomodular.MustBindTransientLazyFunc[Shape](c, func() Shape {
+		return &Circle{}
+	})
+	t.Errorf("panic expcted.")
+}
+
+func TestMustNamedBindTransientLazyFunc_It_Should_Panic_On_Error(t *testing.T) {
+	c := gomodular.New(gomodular.WithStrictBindings())
+	gomodular.MustNamedBindTransientLazyFunc[Shape](c, "name", func() Shape {
+		return &Circle{}
+	})
+
+	defer func() { recover() }()
+	gomodular.MustNamedBindTransientLazyFunc[Shape](c, "name", func() Shape {
+		return &Circle{}
+	})
+	t.Errorf("panic expcted.")
+}
+
+func TestMustResolveAs(t *testing.T) {
+	c := gomodular.New()
+
+	gomodular.MustBind[Shape](c, func() Shape {
+		return &Circle{a: 13}
+	})
+
+	s := gomodular.MustResolveAs[Shape](c)
+	if s.GetArea() != 13 {
+		t.Errorf("Expected 13")
+	}
+}
+
+func TestMustResolveAs_It_Should_Panic_On_Error(t *testing.T) {
+	c := gomodular.New()
+
+	defer func() { recover() }()
+	gomodular.MustResolveAs[Shape](c)
+	t.Errorf("panic expcted.")
+}
+
+func TestMustNamedResolveAs_It_Should_Panic_On_Error(t *testing.T) {
+	c := gomodular.New()
+
+	defer func() { recover() }()
+	gomodular.MustNamedResolveAs[Shape](c, "name")
+	t.Errorf("panic expcted.")
+}
@@ -0,0 +1,57 @@
+package gomodular
+
+import "reflect"
+
+type Optional[T any] struct {
+	value    T
+	provided bool
+}
+
+func (o Optional[T]) Get() (T, bool) {
+	return o.value, o.provided
+}
+
+func (o Optional[T]) Value() T {
+	return o.value
+}
+
+func (o Optional[T]) Provided() bool {
+	return o.provided
+}
+
+func (o *Optional[T]) optionalType() reflect.Type {
+	return typeOf[T]()
+}
+
+func (o *Optional[T]) provide(instance interface{}) {
+	if value, ok := instance.(T); ok {
+		o.value = value
+	}
+	o.provided = true
+}
+
+type optionalDependency interface {
+	optionalType() reflect.Type
+	provide(instance interface{})
+}
+
+var optionalDependencyType = reflect.TypeOf((*optionalDependency)(nil)).Elem()
+
+func isOptional(t reflect.Type) bool {
+	return t.Kind() == reflect.Struct && reflect.PtrTo(t).Implements(optionalDependencyType)
+}
+
+func optionalType(t reflect.Type) reflect.Type {
+	return reflect.New(t).Interface().(optionalDependency).optionalType()
+}
+
+func (c *Gomodular) provideOptional(r *resolution, o optionalDependency, name string) error {
+	if instance, exist, err := c.resolve(r, o.optionalType(), name); exist {
+		if err != nil {
+			return err
+		}
+		o.provide(instance)
+	}
+
+	return nil
+}
@@ -0,0 +1,92 @@
+package gomodular_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/krishpranav/gomodular"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGomodular_Call_With_Optional_Dependency(t *testing.T) {
+	c := gomodular.New()
+
+	err := c.Call(func(s gomodular.Optional[Shape]) {
+		assert.False(t, s.Provided())
+		assert.Nil(t, s.Value())
+	})
+	assert.NoError(t, err)
+
+	err = c.Singleton(func() Shape {
+		return &Circle{a: 13}
+	})
+	assert.NoError(t, err)
+
+	err = c.Call(func(s gomodular.Optional[Shape]) {
+		value, ok := s.Get()
+		assert.True(t, ok)
+		assert.Equal(t, 13, value.GetArea())
+	})
+	assert.NoError(t, err)
+}
+
+func TestGomodular_Resolver_With_Optional_Dependency(t *testing.T) {
+	c := gomodular.New()
+
+	err := c.Singleton(func(s gomodular.Optional[Shape]) Database {
+		assert.False(t, s.Provided())
+		return &MySQL{}
+	})
+	assert.NoError(t, err)
+}
+
+func TestGomodular_Optional_Dependency_With_Failing_Resolver(t *testing.T) {
+	c := gomodular.New()
+
+	err := c.SingletonLazy(func() (Shape, error) {
+		return nil, errors.New("app: error")
+	})
+	assert.NoError(t, err)
+
+	err = c.Call(func(s gomodular.Optional[Shape]) {})
+	assert.ErrorIs(t, err, gomodular.ErrResolverFailed)
+}
+
+func TestGomodular_Fill_With_Optional_Fields(t *testing.T) {
+	c := gomodular.New()
+
+	err := c.NamedSingleton("rounded", func() Shape {
+		return &Circle{a: 13}
+	})
+	assert.NoError(t, err)
+
+	myApp := struct {
+		S       gomodular.Optional[Shape]    `gomodular:"type"`
+		Rounded gomodular.Optional[Shape]    `gomodular:"name=rounded"`
+		D       gomodular.Optional[Database] `gomodular:"type"`
+	}{}
+
+	err = c.Fill(&myApp)
+	assert.NoError(t, err)
+	assert.False(t, myApp.S.Provided())
+	assert.True(t, myApp.Rounded.Provided())
+	assert.Equal(t, 13, myApp.Rounded.Value().GetArea())
+	assert.False(t, myApp.D.Provided())
+}
+
+func TestGomodular_Call_With_Optional_In_Parameters(t *testing.T) {
+	c := gomodular.New()
+
+	type Params struct {
+		gomodular.In
+
+		Tracer gomodular.Optional[Shape] `gomodular:"name=tracer"`
+		Cache  Database                  `gomodular:"optional"`
+	}
+
+	err := c.Call(func(p Params) {
+		assert.False(t, p.Tracer.Provided())
+		assert.Nil(t, p.Cache)
+	})
+	assert.NoError(t, err)
+}
@@ -0,0 +1,96 @@
+package gomodular
+
+import (
+	"reflect"
+	"strconv"
+)
+
+const (
+	LifetimeSingleton = "singleton"
+	LifetimeTransient = "transient"
+	LifetimeScoped    = "scoped"
+)
+
+type EventKind string
+
+const (
+	EventBind    EventKind = "bind"
+	EventReplace EventKind = "replace"
+	EventBuild   EventKind = "build"
+)
+
+type Event struct {
+	Kind     EventKind
+	Type     reflect.Type
+	Name     string
+	Lifetime string
+	Source   string
+	Err      error
+}
+
+func (e Event) String() string {
+	message := "gomodular: " + string(e.Kind) + " " + e.Lifetime + " " + e.Type.String()
+	if e.Name != "" {
+		message += " named " + strconv.Quote(e.Name)
+	}
+	if e.Source != "" {
+		message += " registered at " + e.Source
+	}
+	if e.Err != nil {
+		message += " failed: " + e.Err.Error()
+	}
+	return message
+}
+
+type options struct {
+	strict   bool
+	observer func(Event)
+	lifetime string
+	recover  bool
+	deferred bool
+}
+
+type Option func(*Gomodular)
+
+func WithStrictBindings() Option {
+	return func(c *Gomodular) {
+		c.options.strict = true
+	}
+}
+
+func WithObserver(observer func(Event)) Option {
+	return func(c *Gomodular) {
+		c.options.observer = observer
+	}
+}
+
+func WithDefaultLifetime(lifetime string) Option {
+	return func(c *Gomodular) {
+		c.options.lifetime = lifetime
+	}
+}
+
+func WithPanicRecovery() Option {
+	return func(c *Gomodular) {
+		c.options.recover = true
+	}
+}
+
+func WithDeferredBuild() Option {
+	return func(c *Gomodular) {
+		c.options.deferred = true
+	}
+}
+
+func WithParent(parent *Gomodular) Option {
+	return func(c *Gomodular) {
+		c.parent = parent
+	}
+}
+
+func (c *Gomodular) observe(kind EventKind, abstraction reflect.Type, name string, b *binding, err error) {
+	if c.options.observer == nil {
+		return
+	}
+	c.options.observer(Event{Kind: kind, Type: abstraction, Name: name, Lifetime: b.lifetime(), Source: b.source, Err: err})
+}
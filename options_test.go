@@ -0,0 +1,179 @@
+package gomodular_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/krishpranav/gomodular"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGomodular_WithDefaultLifetime(t *testing.T) {
+	c := gomodular.New(gomodular.WithDefaultLifetime(gomodular.LifetimeTransient))
+
+	built := 0
+	err := c.Register(func() Shape {
+		built++
+		return &Circle{a: built}
+	})
+	assert.NoError(t, err)
+
+	var s Shape
+	assert.NoError(t, c.Resolve(&s))
+	assert.NoError(t, c.Resolve(&s))
+	assert.Equal(t, 3, built)
+
+	scoped := gomodular.New(gomodular.WithDefaultLifetime(gomodular.LifetimeScoped))
+	err = scoped.NamedRegister("rounded", func() Shape {
+		return &Circle{}
+	})
+	assert.NoError(t, err)
+
+	bindings := scoped.Bindings()
+	if assert.Len(t, bindings, 1) {
+		assert.Equal(t, gomodular.LifetimeScoped, bindings[0].Lifetime)
+		assert.Equal(t, "rounded", bindings[0].Name)
+	}
+
+}
+
+func TestGomodular_WithDefaultLifetime_Unknown_Lifetime_It_Should_Fail(t *testing.T) {
+	c := gomodular.New(gomodular.WithDefaultLifetime("forever"))
+
+	err := c.Register(func() Shape {
+		return &Circle{}
+	})
+	assert.EqualError(t, err, "gomodular: unknown lifetime \"forever\"")
+	assert.ErrorIs(t, err, gomodular.ErrInvalidResolver)
+
+	err = c.Contribute(func() Shape {
+		return &Circle{}
+	})
+	assert.ErrorIs(t, err, gomodular.ErrInvalidResolver)
+
+	err = c.Install(&gomodular.Module{
+		Name:      "shapes",
+		Providers: []gomodular.Provider{{Resolver: func() Shape { return &Circle{} }}},
+	})
+	assert.ErrorIs(t, err, gomodular.ErrInvalidResolver)
+
+	assert.Empty(t, c.Bindings())
+}
+
+func TestGomodular_Register_Defaults_To_Singleton(t *testing.T) {
+	c := gomodular.New()
+
+	err := c.Register(func() Shape {
+		return &Circle{}
+	})
+	assert.NoError(t, err)
+
+	bindings := c.Bindings()
+	if assert.Len(t, bindings, 1) {
+		assert.Equal(t, gomodular.LifetimeSingleton, bindings[0].Lifetime)
+		assert.True(t, bindings[0].Instantiated)
+	}
+}
+
+func TestGomodular_WithObserver(t *testing.T) {
+	var events []gomodular.Event
+	c := gomodular.New(gomodular.WithObserver(func(e gomodular.Event) {
+		events = append(events, e)
+	}))
+
+	err := c.SingletonLazy(func() Shape {
+		return &Circle{}
+	})
+	assert.NoError(t, err)
+
+	err = c.NamedTransientLazy("broken", func() (Database, error) {
+		return nil, errors.New("app: error")
+	})
+	assert.NoError(t, err)
+
+	err = c.SingletonLazy(func() Shape {
+		return &Circle{a: 1}
+	})
+	assert.NoError(t, err)
+
+	var s Shape
+	assert.NoError(t, c.Resolve(&s))
+	assert.NoError(t, c.Resolve(&s))
+
+	var db Database
+	assert.Error(t, c.NamedResolve(&db, "broken"))
+
+	kinds := make([]gomodular.EventKind, len(events))
+	for i, e := range events {
+		kinds[i] = e.Kind
+	}
+	assert.Equal(t, []gomodular.EventKind{
+		gomodular.EventBind,
+		gomodular.EventBind,
+		gomodular.EventReplace,
+		gomodular.EventBind,
+		gomodular.EventBuild,
+		gomodular.EventBuild,
+	}, kinds)
+
+	assert.NoError(t, events[4].Err)
+	assert.Equal(t, gomodular.LifetimeSingleton, events[4].Lifetime)
+	assert.Equal(t, "broken", events[5].Name)
+	assert.Regexp(t, `^gomodular: build transient gomodular_test\.Database named "broken" registered at .*options_test\.go:\d+ failed: `, events[5].String())
+}
+
+func TestGomodular_WithPanicRecovery(t *testing.T) {
+	c := gomodular.New(gomodular.WithPanicRecovery())
+
+	err := c.TransientLazy(func() Shape {
+		panic("boom")
+	})
+	assert.NoError(t, err)
+
+	var s Shape
+	err = c.Resolve(&s)
+	assert.ErrorIs(t, err, gomodular.ErrResolverPanicked)
+	assert.ErrorIs(t, err, gomodular.ErrResolverFailed)
+
+	var panicErr *gomodular.PanicError
+	if assert.ErrorAs(t, err, &panicErr) {
+		assert.Equal(t, "boom", panicErr.Value)
+		assert.NotEmpty(t, panicErr.Stack)
+	}
+
+	err = c.Scope().Transient(func() Database {
+		panic("boom")
+	})
+	assert.ErrorIs(t, err, gomodular.ErrResolverPanicked)
+
+	assert.Panics(t, func() {
+		_ = gomodular.New().Transient(func() Shape {
+			panic("boom")
+		})
+	})
+}
+
+func TestGomodular_WithParent(t *testing.T) {
+	parent := gomodular.New()
+
+	err := parent.Singleton(func() Shape {
+		return &Circle{a: 13}
+	})
+	assert.NoError(t, err)
+
+	c := gomodular.New(gomodular.WithParent(parent), gomodular.WithStrictBindings())
+
+	var s Shape
+	assert.NoError(t, c.Resolve(&s))
+	assert.Equal(t, 13, s.GetArea())
+
+	err = c.Singleton(func() Shape {
+		return &Circle{a: 7}
+	})
+	assert.NoError(t, err)
+
+	err = c.Singleton(func() Shape {
+		return &Circle{a: 7}
+	})
+	assert.ErrorIs(t, err, gomodular.ErrAlreadyBound)
+}
@@ -0,0 +1,125 @@
+package gomodular
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"runtime"
+	"strconv"
+)
+
+var contextType = reflect.TypeOf((*context.Context)(nil)).Elem()
+
+type resolution struct {
+	ctx      context.Context
+	name     string
+	path     []reflect.Type
+	bindings []*binding
+	source   string
+}
+
+func (r *resolution) enter(abstraction reflect.Type, name string, b *binding) (*resolution, error) {
+	if r == nil {
+		r = &resolution{}
+	}
+
+	if err := r.context().Err(); err != nil {
+		return nil, err
+	}
+
+	for i, visited := range r.bindings {
+		if visited == b {
+			path := append(append([]reflect.Type{}, r.path[i:]...), abstraction)
+			return nil, &CycleError{Path: path}
+		}
+	}
+
+	next := &resolution{
+		ctx:      r.ctx,
+		name:     name,
+		path:     append(append([]reflect.Type{}, r.path...), abstraction),
+		bindings: append(append([]*binding{}, r.bindings...), b),
+	}
+	if b != nil {
+		next.source = b.source
+	}
+
+	return next, nil
+}
+
+func (c *Gomodular) detectCycle(abstraction reflect.Type, name string, deps []dependency) error {
+	visited := make(map[dependency]bool)
+
+	var walk func(from *Gomodular, deps []dependency, path []reflect.Type) error
+	walk = func(from *Gomodular, deps []dependency, path []reflect.Type) error {
+		for _, d := range deps {
+			if d.Lazy {
+				continue
+			}
+			if d.Type == abstraction && d.Name == name {
+				return &CycleError{Path: append(append([]reflect.Type{}, path...), d.Type)}
+			}
+			if visited[d] {
+				continue
+			}
+			visited[d] = true
+
+			next := append(append([]reflect.Type{}, path...), d.Type)
+			if b, owner, exist := from.lookup(d.Type, d.Name); exist {
+				if err := walk(b.home(owner), b.dependencies(), next); err != nil {
+					return err
+				}
+				continue
+			}
+
+			if elem, ok := groupElem(d.Type); ok && d.Name == "" {
+				if elem == abstraction {
+					return &CycleError{Path: append(next, elem)}
+				}
+				for _, member := range from.group(d.Type) {
+					if err := walk(member.binding.home(member.owner), member.binding.dependencies(), append(next, elem)); err != nil {
+						return err
+					}
+				}
+			}
+		}
+
+		return nil
+	}
+
+	return walk(c, deps, []reflect.Type{abstraction})
+}
+
+func (r *resolution) context() context.Context {
+	if r == nil || r.ctx == nil {
+		return context.Background()
+	}
+	return r.ctx
+}
+
+func (r *resolution) notFound(abstraction reflect.Type, name string) error {
+	if r == nil {
+		return &NotFoundError{Type: abstraction, Name: name, Path: []reflect.Type{abstraction}}
+	}
+	path := append(append([]reflect.Type{}, r.path...), abstraction)
+	return &NotFoundError{Type: abstraction, Name: name, Path: path, Source: r.source}
+}
+
+func (r *resolution) failed(abstraction reflect.Type, err error) error {
+	if r == nil {
+		return &ResolverFailedError{Type: abstraction, Path: []reflect.Type{abstraction}, Err: err}
+	}
+	return &ResolverFailedError{Type: abstraction, Name: r.name, Path: r.path, Source: r.source, Err: err}
+}
+
+func goroutineID() uint64 {
+	var buf [64]byte
+	stack := buf[:runtime.Stack(buf[:], false)]
+	stack = bytes.TrimPrefix(stack, []byte("goroutine "))
+	if i := bytes.IndexByte(stack, ' '); i >= 0 {
+		stack = stack[:i]
+	}
+
+	id, _ := strconv.ParseUint(string(stack), 10, 64)
+	return id
+}
@@ -0,0 +1,25 @@
+package gomodular
+
+import (
+	"reflect"
+	"runtime"
+	"strconv"
+	"strings"
+)
+
+var packagePath = reflect.TypeOf(Gomodular{}).PkgPath()
+
+func callerLocation() string {
+	pcs := make([]uintptr, 16)
+	frames := runtime.CallersFrames(pcs[:runtime.Callers(2, pcs)])
+
+	for {
+		frame, more := frames.Next()
+		if !strings.HasPrefix(frame.Function, packagePath+".") {
+			return frame.File + ":" + strconv.Itoa(frame.Line)
+		}
+		if !more {
+			return ""
+		}
+	}
+}
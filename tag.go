@@ -0,0 +1,35 @@
+package gomodular
+
+import (
+	"reflect"
+
+	"github.com/krishpranav/gomodular/internal/structtag"
+)
+
+type tag struct {
+	name     string
+	optional bool
+	lazy     bool
+}
+
+func parseTag(field reflect.StructField) (tag, bool, error) {
+	value, exist := field.Tag.Lookup("gomodular")
+	if !exist {
+		return tag{}, false, nil
+	}
+
+	t, err := structtag.Parse(field.Name, value)
+	if err != nil {
+		return tag{}, false, &InvalidTagError{Field: field.Name, Tag: value, Reason: err.Error()}
+	}
+
+	if t.Lazy && !isLazyField(field.Type) {
+		return tag{}, false, &InvalidTagError{Field: field.Name, Tag: value, Reason: "lazy field must be a func() (T, error)"}
+	}
+
+	return tag{name: t.Name, optional: t.Optional, lazy: t.Lazy}, true, nil
+}
+
+func isLazyField(t reflect.Type) bool {
+	return t.Kind() == reflect.Func && t.NumIn() == 0 && t.NumOut() == 2 && t.Out(1) == errorType
+}
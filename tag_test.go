@@ -0,0 +1,97 @@
+package gomodular_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/krishpranav/gomodular"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGomodular_Fill_With_Explicit_Name(t *testing.T) {
+	c := gomodular.New()
+
+	err := c.NamedSingleton("postgres-primary", func() Database {
+		return &MySQL{}
+	})
+	assert.NoError(t, err)
+
+	myApp := struct {
+		Primary Database `gomodular:"name=postgres-primary"`
+	}{}
+
+	err = c.Fill(&myApp)
+	assert.NoError(t, err)
+	assert.IsType(t, &MySQL{}, myApp.Primary)
+}
+
+func TestGomodular_Fill_With_Optional_Field(t *testing.T) {
+	c := gomodular.New()
+
+	err := c.Singleton(func() Shape {
+		return &Circle{a: 13}
+	})
+	assert.NoError(t, err)
+
+	myApp := struct {
+		S Shape    `gomodular:"type,optional"`
+		D Database `gomodular:"optional"`
+		R Shape    `gomodular:"name=rounded, optional"`
+	}{}
+
+	err = c.Fill(&myApp)
+	assert.NoError(t, err)
+	assert.Equal(t, 13, myApp.S.GetArea())
+	assert.Nil(t, myApp.D)
+	assert.Nil(t, myApp.R)
+}
+
+func TestGomodular_Fill_With_Lazy_Field(t *testing.T) {
+	c := gomodular.New()
+
+	myApp := struct {
+		S func() (Shape, error) `gomodular:"name=rounded,lazy"`
+	}{}
+
+	err := c.Fill(&myApp)
+	assert.NoError(t, err)
+
+	_, err = myApp.S()
+	assert.ErrorIs(t, err, gomodular.ErrNotFound)
+
+	err = c.NamedSingleton("rounded", func() Shape {
+		return &Circle{a: 13}
+	})
+	assert.NoError(t, err)
+
+	s, err := myApp.S()
+	assert.NoError(t, err)
+	assert.Equal(t, 13, s.GetArea())
+}
+
+func TestGomodular_Fill_With_Invalid_Tags_It_Should_Fail(t *testing.T) {
+	c := gomodular.New()
+
+	for tag, message := range map[string]string{
+		`gomodular:"foo"`:            `unknown key "foo"`,
+		`gomodular:"name=a,foo=b"`:   `unknown key "foo"`,
+		`gomodular:"name="`:          `empty name`,
+		`gomodular:"type=x"`:         `type takes no value`,
+		`gomodular:"optional=true"`:  `optional takes no value`,
+		`gomodular:"type,name"`:      `duplicate key "name"`,
+		`gomodular:"optional,,type"`: `empty key`,
+		`gomodular:"lazy"`:           `lazy field must be a func() (T, error)`,
+	} {
+		err := c.Fill(newTaggedStruct(tag))
+		assert.EqualError(t, err, "gomodular: S has an invalid struct tag: "+message, tag)
+		assert.ErrorIs(t, err, gomodular.ErrInvalidTag)
+	}
+}
+
+func newTaggedStruct(tag string) interface{} {
+	return reflect.New(reflect.StructOf([]reflect.StructField{{
+		Name: "S",
+		Type: reflect.TypeOf((*Shape)(nil)).Elem(),
+		Tag:  reflect.StructTag(tag),
+	}})).Interface()
+}
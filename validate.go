@@ -0,0 +1,113 @@
+package gomodular
+
+import "reflect"
+
+func (c *Gomodular) Validate() error {
+	entries := append(c.allEntries(), c.contributed()...)
+	byBinding := make(map[*binding]entry, len(entries))
+	for _, e := range entries {
+		byBinding[e.binding] = e
+	}
+
+	var errs []error
+	edges := make(map[*binding][]*binding, len(entries))
+
+	for _, e := range entries {
+		for _, d := range e.binding.dependencies() {
+			targets := e.binding.home(c).targets(d)
+			if len(targets) == 0 {
+				if !d.Optional {
+					errs = append(errs, &NotFoundError{Type: d.Type, Name: d.Name, Path: []reflect.Type{e.abstraction, d.Type}, Source: e.binding.source})
+				}
+				continue
+			}
+
+			for _, target := range targets {
+				if e.binding.isSingleton && !e.binding.isScoped && target.binding.isScoped {
+					errs = append(errs, &LifetimeError{
+						Type:               e.abstraction,
+						Name:               e.name,
+						Lifetime:           e.binding.lifetime(),
+						Dependency:         target.abstraction,
+						DependencyName:     target.name,
+						DependencyLifetime: target.binding.lifetime(),
+					})
+				}
+
+				if !d.Lazy {
+					edges[e.binding] = append(edges[e.binding], target.binding)
+				}
+			}
+		}
+	}
+
+	const (
+		unvisited = iota
+		visiting
+		visited
+	)
+	state := make(map[*binding]int, len(entries))
+	var stack []*binding
+
+	var visit func(b *binding)
+	visit = func(b *binding) {
+		state[b] = visiting
+		stack = append(stack, b)
+
+		for _, next := range edges[b] {
+			switch state[next] {
+			case unvisited:
+				visit(next)
+			case visiting:
+				var path []reflect.Type
+				for i := len(stack) - 1; i >= 0; i-- {
+					if stack[i] == next {
+						for _, member := range stack[i:] {
+							path = append(path, byBinding[member].abstraction)
+						}
+						break
+					}
+				}
+				errs = append(errs, &CycleError{Path: append(path, byBinding[next].abstraction)})
+			}
+		}
+
+		stack = stack[:len(stack)-1]
+		state[b] = visited
+	}
+
+	for _, e := range entries {
+		if state[e.binding] == unvisited {
+			visit(e.binding)
+		}
+	}
+
+	return newMultiError(errs)
+}
+
+func (c *Gomodular) allEntries() []entry {
+	entries := c.entries()
+	for _, module := range c.installedModules() {
+		module.mu.RLock()
+		for abstraction, bindings := range module.bindings {
+			for name, b := range bindings {
+				entries = append(entries, entry{abstraction: abstraction, name: name, binding: b, owner: module})
+			}
+		}
+		module.mu.RUnlock()
+	}
+
+	return entries
+}
+
+func (c *Gomodular) targets(d dependency) []entry {
+	if b, owner, exist := c.lookup(d.Type, d.Name); exist {
+		return []entry{{abstraction: d.Type, name: d.Name, binding: b, owner: owner}}
+	}
+
+	if d.Name == "" {
+		return c.group(d.Type)
+	}
+
+	return nil
+}
@@ -0,0 +1,103 @@
+package gomodular_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/krishpranav/gomodular"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGomodular_Validate(t *testing.T) {
+	c := gomodular.New()
+
+	err := c.SingletonLazy(func(s Shape, shapes []Shape, lc gomodular.Lifecycle) Database {
+		return &MySQL{}
+	})
+	assert.NoError(t, err)
+
+	err = c.TransientLazy(func(m gomodular.Optional[Mailer]) Shape {
+		return &Circle{}
+	})
+	assert.NoError(t, err)
+
+	assert.NoError(t, c.Validate())
+}
+
+func TestGomodular_Validate_Reports_Missing_Dependencies(t *testing.T) {
+	var instance = gomodular.New()
+	err := instance.SingletonLazy(func(s Shape) (Database, error) {
+		return &MySQL{}, nil
+	})
+	assert.NoError(t, err)
+
+	type Params struct {
+		gomodular.In
+
+		Rounded Shape `gomodular:"name=rounded"`
+		Cache   Shape `gomodular:"name=cache,optional"`
+	}
+
+	err = instance.NamedTransientLazy("square", func(p Params, m []Mailer) Shape {
+		return &Circle{}
+	})
+	assert.NoError(t, err)
+
+	err = instance.Validate()
+	assert.Regexp(t, `^gomodular: no concrete found for: gomodular_test\.Shape \(required by resolver registered at .*validate_test\.go:\d+\); `+
+		`gomodular: no concrete found for: gomodular_test\.Shape named "rounded" \(required by resolver registered at .*validate_test\.go:\d+\); `+
+		`gomodular: no concrete found for: \[\]gomodular_test\.Mailer \(required by resolver registered at .*validate_test\.go:\d+\)$`, err.Error())
+	assert.ErrorIs(t, err, gomodular.ErrNotFound)
+
+	var multiErr *gomodular.MultiError
+	if assert.ErrorAs(t, err, &multiErr) {
+		assert.Len(t, multiErr.Errors, 3)
+	}
+}
+
+func TestGomodular_Validate_Reports_Cycles(t *testing.T) {
+	c := gomodular.New()
+	scope := c.Scope()
+
+	err := scope.TransientLazy(func(s Shape) Database {
+		return &MySQL{}
+	})
+	assert.NoError(t, err)
+
+	err = c.TransientLazy(func(db Database) Shape {
+		return &Circle{}
+	})
+	assert.NoError(t, err)
+
+	err = c.Validate()
+	assert.Regexp(t, `^gomodular: no concrete found for: gomodular_test\.Database \(required by resolver registered at .*validate_test\.go:\d+\)$`, err.Error())
+
+	err = scope.Validate()
+	assert.EqualError(t, err, "gomodular: circular dependency detected: gomodular_test.Shape -> gomodular_test.Database -> gomodular_test.Shape")
+
+	var cycleErr *gomodular.CycleError
+	assert.True(t, errors.As(err, &cycleErr))
+}
+
+func TestGomodular_Validate_Reports_Lifetime_Mismatch(t *testing.T) {
+	c := gomodular.New()
+
+	err := c.Scoped(func() Shape {
+		return &Circle{}
+	})
+	assert.NoError(t, err)
+
+	err = c.SingletonLazy(func(s Shape) Database {
+		return &MySQL{}
+	})
+	assert.NoError(t, err)
+
+	err = c.TransientLazy(func(s Shape) Mailer {
+		return nil
+	})
+	assert.NoError(t, err)
+
+	err = c.Validate()
+	assert.EqualError(t, err, "gomodular: singleton gomodular_test.Database depends on scoped gomodular_test.Shape")
+	assert.ErrorIs(t, err, gomodular.ErrLifetimeMismatch)
+}